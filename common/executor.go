@@ -0,0 +1,74 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package common
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// Executor runs functions asynchronously, e.g. on a pool of workers that is shared by many parties.
+// Functions given to an Executor must not wait for other functions given to it, which might not get to run.
+type Executor interface {
+	Go(f func())
+}
+
+type goExecutor struct{}
+
+func (goExecutor) Go(f func()) {
+	go f()
+}
+
+// GoExecutor runs every function on a goroutine of its own
+var GoExecutor Executor = goExecutor{}
+
+type executorKey struct{}
+
+// WithExecutor returns a context under which the long-running work of functions that take it, such as the search for
+// safe primes, runs on exec
+func WithExecutor(ctx context.Context, exec Executor) context.Context {
+	return context.WithValue(ctx, executorKey{}, exec)
+}
+
+// ExecutorFrom returns the Executor of the context, or GoExecutor if it has none
+func ExecutorFrom(ctx context.Context) Executor {
+	if exec, ok := ctx.Value(executorKey{}).(Executor); ok && exec != nil {
+		return exec
+	}
+	return GoExecutor
+}
+
+// RunOn runs f on exec and waits for it to return
+func RunOn(exec Executor, f func()) {
+	done := make(chan struct{})
+	exec.Go(func() {
+		defer close(done)
+		f()
+	})
+	<-done
+}
+
+// ForEachOn runs f(0), ..., f(n-1) in parallel on exec and waits for them to return. The calling goroutine runs the
+// calls that exec has not got to yet itself, so that it only waits for calls that are running; unlike RunOn, it may
+// therefore be called by a function that runs on exec.
+func ForEachOn(exec Executor, n int, f func(i int)) {
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	wg.Add(n)
+	run := func() {
+		for i := int(next.Add(1) - 1); i < n; i = int(next.Add(1) - 1) {
+			f(i)
+			wg.Done()
+		}
+	}
+	for k := 1; k < n; k++ {
+		exec.Go(run)
+	}
+	run()
+	wg.Wait()
+}
@@ -69,3 +69,16 @@ func AppendBigIntToBytesSlice(commonBytes []byte, appended *big.Int) []byte {
 	resultBytes = append(resultBytes, appended.Bytes()...)
 	return resultBytes
 }
+
+// Zeroize overwrites the words of each secret with zeros and sets it to 0, so that the secret does not linger in
+// memory until it is garbage collected. Nil values are skipped.
+func Zeroize(secrets ...*big.Int) {
+	for _, x := range secrets {
+		if x == nil {
+			continue
+		}
+		words := x.Bits()
+		clear(words[:cap(words)])
+		x.SetInt64(0)
+	}
+}
@@ -16,7 +16,9 @@ import (
 )
 
 const (
-	mustGetRandomIntMaxBits = 5000
+	// the masks of the factorization proof are below q^3 * N0 * NCap, which is some 9,800 bits for a 521-bit curve
+	// order and moduli of 4096 bits
+	mustGetRandomIntMaxBits = 10000
 )
 
 // MustGetRandomInt panics if it is unable to gather entropy from `io.Reader` or when `bits` is <= 0
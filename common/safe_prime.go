@@ -223,7 +223,7 @@ func runGenPrimeRoutine(
 
 	bigMod := new(big.Int)
 
-	go func() {
+	ExecutorFrom(ctx).Go(func() {
 		defer waitGroup.Done()
 
 		for {
@@ -323,7 +323,7 @@ func runGenPrimeRoutine(
 				}
 			}
 		}
-	}()
+	})
 }
 
 // Pocklington's criterion can be used to prove the primality of `p = 2q + 1`
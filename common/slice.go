@@ -21,6 +21,32 @@ func BigIntsToBytes(bigInts []*big.Int) [][]byte {
 	return bzs
 }
 
+// EqualInts reports whether both slices hold the same integers in the same order; a nil integer equals nothing
+func EqualInts(a, b []*big.Int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] == nil || b[i] == nil || a[i].Cmp(b[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// IndexOfInt returns the index of the first integer in xs that equals x, or -1 if there is none or x is nil
+func IndexOfInt(xs []*big.Int, x *big.Int) int {
+	if x == nil {
+		return -1
+	}
+	for i, xi := range xs {
+		if xi != nil && xi.Cmp(x) == 0 {
+			return i
+		}
+	}
+	return -1
+}
+
 func MultiBytesToBigInts(bytes [][]byte) []*big.Int {
 	ints := make([]*big.Int, len(bytes))
 	for i := range ints {
@@ -51,6 +77,26 @@ func NonEmptyMultiBytes(bzs [][]byte, expectLen ...int) bool {
 	return true
 }
 
+// Returns true when the byte slice is non-empty and at most maxLen bytes long
+func BoundedBytes(bz []byte, maxLen int) bool {
+	return NonEmptyBytes(bz) && len(bz) <= maxLen
+}
+
+// Returns true when all of the slices in the multi-dimensional byte slice are non-empty and at most maxLen bytes long
+func BoundedMultiBytes(bzs [][]byte, maxLen int, expectLen ...int) bool {
+	return NonEmptyMultiBytes(bzs, expectLen...) && MaxLenMultiBytes(bzs, maxLen)
+}
+
+// Returns true when none of the slices in the multi-dimensional byte slice is longer than maxLen bytes; they may be empty
+func MaxLenMultiBytes(bzs [][]byte, maxLen int) bool {
+	for _, bz := range bzs {
+		if maxLen < len(bz) {
+			return false
+		}
+	}
+	return true
+}
+
 // PadToLengthBytesInPlace pad {0, ...} to the front of src if len(src) < length
 // output length is equal to the parameter length
 func PadToLengthBytesInPlace(src []byte, length int) []byte {
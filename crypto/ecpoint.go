@@ -28,6 +28,9 @@ type ECPoint struct {
 }
 
 var (
+	// points serialized before the curve was recorded belong to secp256k1, which used to be the default curve
+	legacyCurve = tss.S256()
+
 	eight    = big.NewInt(8)
 	eightInv = new(big.Int).ModInverse(eight, edwards.Edwards().Params().N)
 )
@@ -91,6 +94,19 @@ func (p *ECPoint) Equals(p2 *ECPoint) bool {
 	return p.X().Cmp(p2.X()) == 0 && p.Y().Cmp(p2.Y()) == 0
 }
 
+// EqualECPoints reports whether both slices hold the same points in the same order
+func EqualECPoints(a, b []*ECPoint) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equals(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 func (p *ECPoint) SetCurve(curve elliptic.Curve) *ECPoint {
 	p.curve = curve
 	return p
@@ -163,9 +179,21 @@ func UnFlattenECPoints(curve elliptic.Curve, in []*big.Int, noCurveCheck ...bool
 
 // ----- //
 // Gob helpers for if you choose to encode messages with Gob.
+// The encoding starts with a zero length, which a legacy encoding without the curve name cannot, and the curve name.
 
 func (p *ECPoint) GobEncode() ([]byte, error) {
+	ecName, ok := tss.GetCurveName(p.curve)
+	if !ok {
+		return nil, fmt.Errorf("cannot find %T name in curve registry, please call tss.RegisterCurve(name, curve) to register it first", p.curve)
+	}
 	buf := &bytes.Buffer{}
+	if err := binary.Write(buf, binary.LittleEndian, uint32(0)); err != nil {
+		return nil, err
+	}
+	if err := binary.Write(buf, binary.LittleEndian, uint32(len(ecName))); err != nil {
+		return nil, err
+	}
+	buf.WriteString(string(ecName))
 	x, err := p.coords[0].GobEncode()
 	if err != nil {
 		return nil, err
@@ -195,6 +223,23 @@ func (p *ECPoint) GobDecode(buf []byte) error {
 	if err := binary.Read(reader, binary.LittleEndian, &length); err != nil {
 		return err
 	}
+	curve := legacyCurve
+	if length == 0 {
+		if err := binary.Read(reader, binary.LittleEndian, &length); err != nil {
+			return err
+		}
+		name := make([]byte, length)
+		if n, err := reader.Read(name); n != int(length) || err != nil {
+			return fmt.Errorf("gob decode failed: %v", err)
+		}
+		var ok bool
+		if curve, ok = tss.GetCurveByName(tss.CurveName(name)); !ok {
+			return fmt.Errorf("cannot find curve named with %s in curve registry, please call tss.RegisterCurve(name, curve) to register it first", name)
+		}
+		if err := binary.Read(reader, binary.LittleEndian, &length); err != nil {
+			return err
+		}
+	}
 	x := make([]byte, length)
 	n, err := reader.Read(x)
 	if n != int(length) || err != nil {
@@ -217,10 +262,10 @@ func (p *ECPoint) GobDecode(buf []byte) error {
 	if err := Y.GobDecode(y); err != nil {
 		return err
 	}
-	p.curve = tss.EC()
+	p.curve = curve
 	p.coords = [2]*big.Int{X, Y}
 	if !p.IsOnCurve() {
-		return errors.New("ECPoint.UnmarshalJSON: the point is not on the elliptic curve")
+		return errors.New("ECPoint.GobDecode: the point is not on the elliptic curve")
 	}
 	return nil
 }
@@ -260,8 +305,7 @@ func (p *ECPoint) UnmarshalJSON(payload []byte) error {
 		}
 		p.curve = ec
 	} else {
-		// forward compatible, use global ec as default value
-		p.curve = tss.EC()
+		p.curve = legacyCurve
 	}
 
 	if !p.IsOnCurve() {
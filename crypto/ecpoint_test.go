@@ -7,6 +7,8 @@
 package crypto_test
 
 import (
+	"bytes"
+	"encoding/gob"
 	"encoding/hex"
 	"encoding/json"
 	"math/big"
@@ -33,24 +35,24 @@ func TestFlattenECPoints(t *testing.T) {
 	}{{
 		name: "flatten with 2 points (happy)",
 		args: args{[]*ECPoint{
-			NewECPointNoCurveCheck(tss.EC(), big.NewInt(1), big.NewInt(2)),
-			NewECPointNoCurveCheck(tss.EC(), big.NewInt(3), big.NewInt(4)),
+			NewECPointNoCurveCheck(tss.S256(), big.NewInt(1), big.NewInt(2)),
+			NewECPointNoCurveCheck(tss.S256(), big.NewInt(3), big.NewInt(4)),
 		}},
 		want: []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4)},
 	}, {
 		name: "flatten with nil point (expects err)",
 		args: args{[]*ECPoint{
-			NewECPointNoCurveCheck(tss.EC(), big.NewInt(1), big.NewInt(2)),
+			NewECPointNoCurveCheck(tss.S256(), big.NewInt(1), big.NewInt(2)),
 			nil,
-			NewECPointNoCurveCheck(tss.EC(), big.NewInt(3), big.NewInt(4))},
+			NewECPointNoCurveCheck(tss.S256(), big.NewInt(3), big.NewInt(4))},
 		},
 		want:    nil,
 		wantErr: true,
 	}, {
 		name: "flatten with nil coordinate (expects err)",
 		args: args{[]*ECPoint{
-			NewECPointNoCurveCheck(tss.EC(), big.NewInt(1), big.NewInt(2)),
-			NewECPointNoCurveCheck(tss.EC(), nil, big.NewInt(4))},
+			NewECPointNoCurveCheck(tss.S256(), big.NewInt(1), big.NewInt(2)),
+			NewECPointNoCurveCheck(tss.S256(), nil, big.NewInt(4))},
 		},
 		want:    nil,
 		wantErr: true,
@@ -87,8 +89,8 @@ func TestUnFlattenECPoints(t *testing.T) {
 		name: "un-flatten 2 points (happy)",
 		args: args{[]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4)}},
 		want: []*ECPoint{
-			NewECPointNoCurveCheck(tss.EC(), big.NewInt(1), big.NewInt(2)),
-			NewECPointNoCurveCheck(tss.EC(), big.NewInt(3), big.NewInt(4)),
+			NewECPointNoCurveCheck(tss.S256(), big.NewInt(1), big.NewInt(2)),
+			NewECPointNoCurveCheck(tss.S256(), big.NewInt(3), big.NewInt(4)),
 		},
 	}, {
 		name:    "un-flatten uneven len(points) (expects err)",
@@ -108,7 +110,7 @@ func TestUnFlattenECPoints(t *testing.T) {
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := UnFlattenECPoints(tss.EC(), tt.args.in, true)
+			got, err := UnFlattenECPoints(tss.S256(), tt.args.in, true)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("UnFlattenECPoints() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -165,3 +167,35 @@ func TestEdwardsEcpointJsonSerialization(t *testing.T) {
 	assert.True(t, point.Equals(&umpoint))
 	assert.True(t, reflect.TypeOf(point.Curve()) == reflect.TypeOf(umpoint.Curve()))
 }
+
+func TestP256EcpointSerialization(t *testing.T) {
+	ec := tss.P256()
+	name, ok := tss.GetCurveName(ec)
+	assert.True(t, ok)
+	assert.Equal(t, tss.Secp256r1, name)
+
+	point := ScalarBaseMult(ec, big.NewInt(42))
+	bz, err := json.Marshal(point)
+	assert.NoError(t, err)
+	var umpoint ECPoint
+	err = json.Unmarshal(bz, &umpoint)
+	assert.NoError(t, err)
+	assert.True(t, point.Equals(&umpoint))
+	assert.True(t, tss.SameCurve(ec, umpoint.Curve()))
+
+	buf := &bytes.Buffer{}
+	err = gob.NewEncoder(buf).Encode(point)
+	assert.NoError(t, err)
+	var gobPoint ECPoint
+	err = gob.NewDecoder(buf).Decode(&gobPoint)
+	assert.NoError(t, err)
+	assert.True(t, point.Equals(&gobPoint))
+	assert.True(t, tss.SameCurve(ec, gobPoint.Curve()))
+
+	// a point of another curve does not decode as a point of P-256
+	other, err := json.Marshal(ScalarBaseMult(tss.S256(), big.NewInt(42)))
+	assert.NoError(t, err)
+	err = json.Unmarshal(other, &umpoint)
+	assert.NoError(t, err)
+	assert.False(t, tss.SameCurve(ec, umpoint.Curve()))
+}
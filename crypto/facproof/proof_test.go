@@ -27,7 +27,7 @@ const (
 var Session = []byte("session")
 
 func TestFac(test *testing.T) {
-	ec := tss.EC()
+	ec := tss.S256()
 
 	N0p := common.GetRandomPrimeInt(rand.Reader, testSafePrimeBits)
 	N0q := common.GetRandomPrimeInt(rand.Reader, testSafePrimeBits)
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"math/big"
+	"sync/atomic"
 
 	"github.com/SafeMPC/tss-lib/common"
 )
@@ -111,10 +112,15 @@ func NewProofFromBytes(bzs [][]byte) (*ProofMod, error) {
 	}, nil
 }
 
-func (pf *ProofMod) Verify(Session []byte, N *big.Int) bool {
+// Verify verifies the proof, running its iterations on the given executor or on goroutines of its own
+func (pf *ProofMod) Verify(Session []byte, N *big.Int, optionalExecutor ...common.Executor) bool {
 	if pf == nil || !pf.ValidateBasic() {
 		return false
 	}
+	exec := common.GoExecutor
+	if 0 < len(optionalExecutor) {
+		exec = optionalExecutor[0]
+	}
 	// TODO: add basic properties checker
 	if isQuadraticResidue(pf.W, N) {
 		return false
@@ -157,51 +163,40 @@ func (pf *ProofMod) Verify(Session []byte, N *big.Int) bool {
 		}
 	}
 
-	chs := make(chan bool, Iterations*2)
-	for i := 0; i < Iterations; i++ {
-		go func(i int) {
-			left := modN.Exp(pf.Z[i], N)
-			if left.Cmp(Y[i]) != 0 {
-				chs <- false
-				return
-			}
-			chs <- true
-		}(i)
-
-		go func(i int) {
-			a := pf.A.Bit(i)
-			b := pf.B.Bit(i)
-			if a != 0 && a != 1 {
-				chs <- false
-				return
-			}
-			if b != 0 && b != 1 {
-				chs <- false
-				return
+	// the 2 checks of each iteration run in parallel
+	var failed atomic.Bool
+	common.ForEachOn(exec, Iterations*2, func(k int) {
+		if i := k / 2; k%2 == 0 {
+			if left := modN.Exp(pf.Z[i], N); left.Cmp(Y[i]) != 0 {
+				failed.Store(true)
 			}
-			left := modN.Exp(pf.X[i], big.NewInt(4))
-			right := Y[i]
-			if a > 0 {
-				right = modN.Mul(big.NewInt(-1), right)
-			}
-			if b > 0 {
-				right = modN.Mul(pf.W, right)
-			}
-			if left.Cmp(right) != 0 {
-				chs <- false
-				return
-			}
-			chs <- true
-		}(i)
-	}
-
-	for i := 0; i < Iterations*2; i++ {
-		if !<-chs {
-			return false
+		} else if !pf.verifyFourthRoot(N, Y[i], i) {
+			failed.Store(true)
 		}
-	}
+	})
+	return !failed.Load()
+}
 
-	return true
+// verifyFourthRoot checks that X_i^4 = (-1)^a_i * w^b_i * y_i mod N
+func (pf *ProofMod) verifyFourthRoot(N, Yi *big.Int, i int) bool {
+	modN := common.ModInt(N)
+	a := pf.A.Bit(i)
+	b := pf.B.Bit(i)
+	if a != 0 && a != 1 {
+		return false
+	}
+	if b != 0 && b != 1 {
+		return false
+	}
+	left := modN.Exp(pf.X[i], big.NewInt(4))
+	right := Yi
+	if a > 0 {
+		right = modN.Mul(big.NewInt(-1), right)
+	}
+	if b > 0 {
+		right = modN.Mul(pf.W, right)
+	}
+	return left.Cmp(right) == 0
 }
 
 func (pf *ProofMod) ValidateBasic() bool {
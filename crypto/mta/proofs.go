@@ -194,6 +194,9 @@ func (pf *ProofBobWC) Verify(Session []byte, ec elliptic.Curve, pk *paillier.Pub
 	if pk == nil || NTilde == nil || h1 == nil || h2 == nil || c1 == nil || c2 == nil {
 		return false
 	}
+	if !hasModuliFor(ec, pk, NTilde) {
+		return false
+	}
 
 	q := ec.Params().N
 	q3 := new(big.Int).Mul(q, q)   // q^2
@@ -201,6 +204,9 @@ func (pf *ProofBobWC) Verify(Session []byte, ec elliptic.Curve, pk *paillier.Pub
 	q7 := new(big.Int).Mul(q3, q3) // q^6
 	q7 = new(big.Int).Mul(q7, q)   // q^7
 
+	if !common.IsInInterval(c1, pk.NSquare()) || !common.IsInInterval(c2, pk.NSquare()) {
+		return false
+	}
 	if !common.IsInInterval(pf.Z, NTilde) {
 		return false
 	}
@@ -268,6 +274,9 @@ func (pf *ProofBobWC) Verify(Session []byte, ec elliptic.Curve, pk *paillier.Pub
 	if pf.T1.Cmp(q7) > 0 {
 		return false
 	}
+	if bound := maskedBound(q, NTilde); pf.S2.Cmp(bound) >= 0 || pf.T2.Cmp(bound) >= 0 {
+		return false
+	}
 
 	// 1-2. e'
 	var e *big.Int
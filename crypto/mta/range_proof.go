@@ -15,6 +15,7 @@ import (
 
 	"github.com/SafeMPC/tss-lib/common"
 	"github.com/SafeMPC/tss-lib/crypto/paillier"
+	"github.com/SafeMPC/tss-lib/tss"
 )
 
 const (
@@ -32,8 +33,24 @@ type (
 	}
 )
 
+// hasModuliFor reports whether the Paillier modulus and NTilde are large enough for the range proofs over the curve.
+// The proofs bound the values in powers of the curve order rather than of the moduli, so the moduli must grow with
+// the curve instead; see tss.CurveModulusBits.
+func hasModuliFor(ec elliptic.Curve, pk *paillier.PublicKey, NTilde *big.Int) bool {
+	minBits := tss.CurveModulusBits(ec)
+	return minBits <= pk.N.BitLen() && minBits <= NTilde.BitLen()
+}
+
+// maskedBound is the bound on the responses that are masked in NTilde, e*rho + gamma with e < q, rho < q*NTilde and
+// gamma < q^3*NTilde; unlike the bounds on the responses in powers of q, it scales with the size of NTilde
+func maskedBound(q, NTilde *big.Int) *big.Int {
+	q2 := new(big.Int).Mul(q, q)
+	bound := new(big.Int).Add(new(big.Int).Mul(q2, q), q2)
+	return bound.Mul(bound, NTilde)
+}
+
 // ProveRangeAlice implements Alice's range proof used in the MtA and MtAwc protocols from GG18Spec (9) Fig. 9.
-func ProveRangeAlice(ec elliptic.Curve, pk *paillier.PublicKey, c, NTilde, h1, h2, m, r *big.Int, rand io.Reader) (*RangeProofAlice, error) {
+func ProveRangeAlice(Session []byte, ec elliptic.Curve, pk *paillier.PublicKey, c, NTilde, h1, h2, m, r *big.Int, rand io.Reader) (*RangeProofAlice, error) {
 	if pk == nil || NTilde == nil || h1 == nil || h2 == nil || c == nil || m == nil || r == nil {
 		return nil, errors.New("ProveRangeAlice constructor received nil value(s)")
 	}
@@ -72,7 +89,7 @@ func ProveRangeAlice(ec elliptic.Curve, pk *paillier.PublicKey, c, NTilde, h1, h
 	// 8-9. e'
 	var e *big.Int
 	{ // must use RejectionSample
-		eHash := common.SHA512_256i(append(pk.AsInts(), c, z, u, w)...)
+		eHash := common.SHA512_256i_TAGGED(Session, append(pk.AsInts(), c, z, u, w)...)
 		e = common.RejectionSample(q, eHash)
 	}
 
@@ -105,15 +122,21 @@ func RangeProofAliceFromBytes(bzs [][]byte) (*RangeProofAlice, error) {
 	}, nil
 }
 
-func (pf *RangeProofAlice) Verify(ec elliptic.Curve, pk *paillier.PublicKey, NTilde, h1, h2, c *big.Int) bool {
+func (pf *RangeProofAlice) Verify(Session []byte, ec elliptic.Curve, pk *paillier.PublicKey, NTilde, h1, h2, c *big.Int) bool {
 	if pf == nil || !pf.ValidateBasic() || pk == nil || NTilde == nil || h1 == nil || h2 == nil || c == nil {
 		return false
 	}
+	if !hasModuliFor(ec, pk, NTilde) {
+		return false
+	}
 
 	q := ec.Params().N
 	q3 := new(big.Int).Mul(q, q)
 	q3 = new(big.Int).Mul(q, q3)
 
+	if !common.IsInInterval(c, pk.NSquare()) {
+		return false
+	}
 	if !common.IsInInterval(pf.Z, NTilde) {
 		return false
 	}
@@ -155,11 +178,14 @@ func (pf *RangeProofAlice) Verify(ec elliptic.Curve, pk *paillier.PublicKey, NTi
 	if pf.S1.Cmp(q3) == 1 {
 		return false
 	}
+	if pf.S2.Cmp(maskedBound(q, NTilde)) >= 0 {
+		return false
+	}
 
 	// 1-2. e'
 	var e *big.Int
 	{ // must use RejectionSample
-		eHash := common.SHA512_256i(append(pk.AsInts(), c, pf.Z, pf.U, pf.W)...)
+		eHash := common.SHA512_256i_TAGGED(Session, append(pk.AsInts(), c, pf.Z, pf.U, pf.W)...)
 		e = common.RejectionSample(q, eHash)
 	}
 
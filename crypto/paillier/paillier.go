@@ -196,10 +196,11 @@ func (privateKey *PrivateKey) Decrypt(c *big.Int) (m *big.Int, err error) {
 // An efficient non-interactive statistical zero-knowledge proof system for quasi-safe prime products.
 // In: In Proc. of the 5th ACM Conference on Computer and Communications Security (CCS-98. Citeseer (1998)
 
-func (privateKey *PrivateKey) Proof(k *big.Int, ecdsaPub *crypto2.ECPoint) Proof {
+// Proof proves that the key is well-formed, generating its challenges on the given executor or on goroutines of its own
+func (privateKey *PrivateKey) Proof(k *big.Int, ecdsaPub *crypto2.ECPoint, optionalExecutor ...common.Executor) Proof {
 	var pi Proof
 	iters := ProofIters
-	xs := GenerateXs(iters, k, privateKey.N, ecdsaPub)
+	xs := GenerateXs(iters, k, privateKey.N, ecdsaPub, optionalExecutor...)
 	for i := 0; i < iters; i++ {
 		M := new(big.Int).ModInverse(privateKey.N, privateKey.PhiN)
 		pi[i] = new(big.Int).Exp(xs[i], M, privateKey.N)
@@ -207,40 +208,40 @@ func (privateKey *PrivateKey) Proof(k *big.Int, ecdsaPub *crypto2.ECPoint) Proof
 	return pi
 }
 
-func (pf Proof) Verify(pkN, k *big.Int, ecdsaPub *crypto2.ECPoint) (bool, error) {
+// Verify verifies the proof, running its checks on the given executor or on goroutines of its own
+func (pf Proof) Verify(pkN, k *big.Int, ecdsaPub *crypto2.ECPoint, optionalExecutor ...common.Executor) (bool, error) {
+	exec := common.GoExecutor
+	if 0 < len(optionalExecutor) {
+		exec = optionalExecutor[0]
+	}
 	iters := ProofIters
-	pch, xch := make(chan bool, 1), make(chan []*big.Int, 1) // buffered to allow early exit
-	prms := primes.Until(verifyPrimesUntil).List()           // uses cache primed in init()
-	go func(ch chan<- bool) {
-		for _, prm := range prms {
-			// If prm divides N then Return 0
-			if new(big.Int).Mod(pkN, big.NewInt(prm)).Cmp(zero) == 0 {
-				ch <- false // is divisible
-				return
-			}
-		}
-		ch <- true
-	}(pch)
-	go func(ch chan<- []*big.Int) {
-		ch <- GenerateXs(iters, k, pkN, ecdsaPub)
-	}(xch)
-	for j := 0; j < 2; j++ {
-		select {
-		case ok := <-pch:
-			if !ok {
-				return false, nil
-			}
-		case xs := <-xch:
-			if len(xs) != iters {
-				return false, fmt.Errorf("paillier proof verify: expected %d xs but got %d", iters, len(xs))
-			}
-			for i, xi := range xs {
-				xiModN := new(big.Int).Mod(xi, pkN)
-				yiExpN := new(big.Int).Exp(pf[i], pkN, pkN)
-				if xiModN.Cmp(yiExpN) != 0 {
-					return false, nil
+	prms := primes.Until(verifyPrimesUntil).List() // uses cache primed in init()
+	var divisible bool
+	var xs []*big.Int
+	common.ForEachOn(exec, 2, func(task int) {
+		if task == 0 {
+			for _, prm := range prms {
+				// If prm divides N then Return 0
+				if new(big.Int).Mod(pkN, big.NewInt(prm)).Cmp(zero) == 0 {
+					divisible = true
+					return
 				}
 			}
+			return
+		}
+		xs = GenerateXs(iters, k, pkN, ecdsaPub, exec)
+	})
+	if divisible {
+		return false, nil
+	}
+	if len(xs) != iters {
+		return false, fmt.Errorf("paillier proof verify: expected %d xs but got %d", iters, len(xs))
+	}
+	for i, xi := range xs {
+		xiModN := new(big.Int).Mod(xi, pkN)
+		yiExpN := new(big.Int).Exp(pf[i], pkN, pkN)
+		if xiModN.Cmp(yiExpN) != 0 {
+			return false, nil
 		}
 	}
 	return true, nil
@@ -253,31 +254,29 @@ func L(u, N *big.Int) *big.Int {
 	return new(big.Int).Div(t, N)
 }
 
-// GenerateXs generates the challenges used in Paillier key Proof
-func GenerateXs(m int, k, N *big.Int, ecdsaPub *crypto2.ECPoint) []*big.Int {
+// GenerateXs generates the challenges used in Paillier key Proof, hashing their blocks on the given executor or on
+// goroutines of its own
+func GenerateXs(m int, k, N *big.Int, ecdsaPub *crypto2.ECPoint, optionalExecutor ...common.Executor) []*big.Int {
+	exec := common.GoExecutor
+	if 0 < len(optionalExecutor) {
+		exec = optionalExecutor[0]
+	}
 	var i, n int
 	ret := make([]*big.Int, m)
 	sX, sY := ecdsaPub.X(), ecdsaPub.Y()
 	kb, sXb, sYb, Nb := k.Bytes(), sX.Bytes(), sY.Bytes(), N.Bytes()
 	bits := N.BitLen()
 	blocks := int(gmath.Ceil(float64(bits) / 256))
-	chs := make([]chan []byte, blocks)
-	for k := range chs {
-		chs[k] = make(chan []byte)
-	}
+	hashes := make([][]byte, blocks)
 	for i < m {
 		xi := make([]byte, 0, blocks*32)
 		ib := []byte(strconv.Itoa(i))
 		nb := []byte(strconv.Itoa(n))
-		for j := 0; j < blocks; j++ {
-			go func(j int) {
-				jBz := []byte(strconv.Itoa(j))
-				hash := common.SHA512_256(ib, jBz, nb, kb, sXb, sYb, Nb)
-				chs[j] <- hash
-			}(j)
-		}
-		for _, ch := range chs { // must be in order
-			rx := <-ch
+		common.ForEachOn(exec, blocks, func(j int) {
+			jBz := []byte(strconv.Itoa(j))
+			hashes[j] = common.SHA512_256(ib, jBz, nb, kb, sXb, sYb, Nb)
+		})
+		for _, rx := range hashes { // must be in order
 			if rx == nil { // this should never happen. see: https://golang.org/pkg/hash/#Hash
 				panic(errors.New("GenerateXs hash write error!"))
 			}
@@ -111,24 +111,24 @@ func TestHomoAdd(t *testing.T) {
 
 func TestProofVerify(t *testing.T) {
 	setUp(t)
-	ki := common.MustGetRandomInt(rand.Reader, 256)                     // index
-	ui := common.GetRandomPositiveInt(rand.Reader, tss.EC().Params().N) // ECDSA private
-	yX, yY := tss.EC().ScalarBaseMult(ui.Bytes())                       // ECDSA public
-	proof := privateKey.Proof(ki, crypto.NewECPointNoCurveCheck(tss.EC(), yX, yY))
-	res, err := proof.Verify(publicKey.N, ki, crypto.NewECPointNoCurveCheck(tss.EC(), yX, yY))
+	ki := common.MustGetRandomInt(rand.Reader, 256)                       // index
+	ui := common.GetRandomPositiveInt(rand.Reader, tss.S256().Params().N) // ECDSA private
+	yX, yY := tss.S256().ScalarBaseMult(ui.Bytes())                       // ECDSA public
+	proof := privateKey.Proof(ki, crypto.NewECPointNoCurveCheck(tss.S256(), yX, yY))
+	res, err := proof.Verify(publicKey.N, ki, crypto.NewECPointNoCurveCheck(tss.S256(), yX, yY))
 	assert.NoError(t, err)
 	assert.True(t, res, "proof verify result must be true")
 }
 
 func TestProofVerifyFail(t *testing.T) {
 	setUp(t)
-	ki := common.MustGetRandomInt(rand.Reader, 256)                     // index
-	ui := common.GetRandomPositiveInt(rand.Reader, tss.EC().Params().N) // ECDSA private
-	yX, yY := tss.EC().ScalarBaseMult(ui.Bytes())                       // ECDSA public
-	proof := privateKey.Proof(ki, crypto.NewECPointNoCurveCheck(tss.EC(), yX, yY))
+	ki := common.MustGetRandomInt(rand.Reader, 256)                       // index
+	ui := common.GetRandomPositiveInt(rand.Reader, tss.S256().Params().N) // ECDSA private
+	yX, yY := tss.S256().ScalarBaseMult(ui.Bytes())                       // ECDSA public
+	proof := privateKey.Proof(ki, crypto.NewECPointNoCurveCheck(tss.S256(), yX, yY))
 	last := proof[len(proof)-1]
 	last.Sub(last, big.NewInt(1))
-	res, err := proof.Verify(publicKey.N, ki, crypto.NewECPointNoCurveCheck(tss.EC(), yX, yY))
+	res, err := proof.Verify(publicKey.N, ki, crypto.NewECPointNoCurveCheck(tss.S256(), yX, yY))
 	assert.NoError(t, err)
 	assert.False(t, res, "proof verify result must be true")
 }
@@ -149,7 +149,7 @@ func TestGenerateXs(t *testing.T) {
 	sY := common.MustGetRandomInt(rand.Reader, 256)
 	N := common.GetRandomPrimeInt(rand.Reader, 2048)
 
-	xs := GenerateXs(13, k, N, crypto.NewECPointNoCurveCheck(tss.EC(), sX, sY))
+	xs := GenerateXs(13, k, N, crypto.NewECPointNoCurveCheck(tss.S256(), sX, sY))
 	assert.Equal(t, 13, len(xs))
 	for _, xi := range xs {
 		assert.True(t, common.IsNumberInMultiplicativeGroup(N, xi))
@@ -59,6 +59,9 @@ func (pf *ZKProof) Verify(Session []byte, X *crypto.ECPoint) bool {
 	ec := X.Curve()
 	ecParams := ec.Params()
 	q := ecParams.N
+	if !common.IsInInterval(pf.T, q) {
+		return false
+	}
 	g := crypto.NewECPointNoCurveCheck(ec, ecParams.Gx, ecParams.Gy)
 
 	var c *big.Int
@@ -113,6 +116,9 @@ func (pf *ZKVProof) Verify(Session []byte, V, R *crypto.ECPoint) bool {
 	ec := V.Curve()
 	ecParams := ec.Params()
 	q := ecParams.N
+	if !common.IsInInterval(pf.T, q) || !common.IsInInterval(pf.U, q) {
+		return false
+	}
 	g := crypto.NewECPointNoCurveCheck(ec, ecParams.Gx, ecParams.Gy)
 
 	var c *big.Int
@@ -8,6 +8,7 @@ package schnorr_test
 
 import (
 	"crypto/rand"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -21,9 +22,9 @@ import (
 var Session = []byte("session")
 
 func TestSchnorrProof(t *testing.T) {
-	q := tss.EC().Params().N
+	q := tss.S256().Params().N
 	u := common.GetRandomPositiveInt(rand.Reader, q)
-	uG := crypto.ScalarBaseMult(tss.EC(), u)
+	uG := crypto.ScalarBaseMult(tss.S256(), u)
 	proof, _ := NewZKProof(Session, u, uG, rand.Reader)
 
 	assert.True(t, proof.Alpha.IsOnCurve())
@@ -33,22 +34,25 @@ func TestSchnorrProof(t *testing.T) {
 }
 
 func TestSchnorrProofVerify(t *testing.T) {
-	q := tss.EC().Params().N
+	q := tss.S256().Params().N
 	u := common.GetRandomPositiveInt(rand.Reader, q)
-	X := crypto.ScalarBaseMult(tss.EC(), u)
+	X := crypto.ScalarBaseMult(tss.S256(), u)
 
 	proof, _ := NewZKProof(Session, u, X, rand.Reader)
 	res := proof.Verify(Session, X)
 
 	assert.True(t, res, "verify result must be true")
+
+	proof.T = new(big.Int).Add(proof.T, q)
+	assert.False(t, proof.Verify(Session, X), "a response that is not less than q must be rejected")
 }
 
 func TestSchnorrProofVerifyBadX(t *testing.T) {
-	q := tss.EC().Params().N
+	q := tss.S256().Params().N
 	u := common.GetRandomPositiveInt(rand.Reader, q)
 	u2 := common.GetRandomPositiveInt(rand.Reader, q)
-	X := crypto.ScalarBaseMult(tss.EC(), u)
-	X2 := crypto.ScalarBaseMult(tss.EC(), u2)
+	X := crypto.ScalarBaseMult(tss.S256(), u)
+	X2 := crypto.ScalarBaseMult(tss.S256(), u2)
 
 	proof, _ := NewZKProof(Session, u2, X2, rand.Reader)
 	res := proof.Verify(Session, X)
@@ -57,13 +61,13 @@ func TestSchnorrProofVerifyBadX(t *testing.T) {
 }
 
 func TestSchnorrVProofVerify(t *testing.T) {
-	q := tss.EC().Params().N
+	q := tss.S256().Params().N
 	k := common.GetRandomPositiveInt(rand.Reader, q)
 	s := common.GetRandomPositiveInt(rand.Reader, q)
 	l := common.GetRandomPositiveInt(rand.Reader, q)
-	R := crypto.ScalarBaseMult(tss.EC(), k) // k_-1 * G
+	R := crypto.ScalarBaseMult(tss.S256(), k) // k_-1 * G
 	Rs := R.ScalarMult(s)
-	lG := crypto.ScalarBaseMult(tss.EC(), l)
+	lG := crypto.ScalarBaseMult(tss.S256(), l)
 	V, _ := Rs.Add(lG)
 
 	proof, _ := NewZKVProof(Session, V, R, s, l, rand.Reader)
@@ -73,11 +77,11 @@ func TestSchnorrVProofVerify(t *testing.T) {
 }
 
 func TestSchnorrVProofVerifyBadPartialV(t *testing.T) {
-	q := tss.EC().Params().N
+	q := tss.S256().Params().N
 	k := common.GetRandomPositiveInt(rand.Reader, q)
 	s := common.GetRandomPositiveInt(rand.Reader, q)
 	l := common.GetRandomPositiveInt(rand.Reader, q)
-	R := crypto.ScalarBaseMult(tss.EC(), k) // k_-1 * G
+	R := crypto.ScalarBaseMult(tss.S256(), k) // k_-1 * G
 	Rs := R.ScalarMult(s)
 	V := Rs
 
@@ -88,14 +92,14 @@ func TestSchnorrVProofVerifyBadPartialV(t *testing.T) {
 }
 
 func TestSchnorrVProofVerifyBadS(t *testing.T) {
-	q := tss.EC().Params().N
+	q := tss.S256().Params().N
 	k := common.GetRandomPositiveInt(rand.Reader, q)
 	s := common.GetRandomPositiveInt(rand.Reader, q)
 	s2 := common.GetRandomPositiveInt(rand.Reader, q)
 	l := common.GetRandomPositiveInt(rand.Reader, q)
-	R := crypto.ScalarBaseMult(tss.EC(), k) // k_-1 * G
+	R := crypto.ScalarBaseMult(tss.S256(), k) // k_-1 * G
 	Rs := R.ScalarMult(s)
-	lG := crypto.ScalarBaseMult(tss.EC(), l)
+	lG := crypto.ScalarBaseMult(tss.S256(), l)
 	V, _ := Rs.Add(lG)
 
 	proof, _ := NewZKVProof(Session, V, R, s2, l, rand.Reader)
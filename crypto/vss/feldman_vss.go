@@ -96,6 +96,9 @@ func (share *Share) Verify(ec elliptic.Curve, threshold int, vs Vs) bool {
 	if share.Threshold != threshold || vs == nil || len(vs) != threshold+1 {
 		return false
 	}
+	if !common.IsInInterval(share.Share, ec.Params().N) {
+		return false
+	}
 	var err error
 	modQ := common.ModInt(ec.Params().N)
 	v, t := vs[0], one // YRO : we need to have our accumulator outside of the loop
@@ -145,6 +148,71 @@ func (shares Shares) ReConstruct(ec elliptic.Curve) (secret *big.Int, err error)
 	return secret, nil
 }
 
+// CheckPublicShares checks that the public shares Xj = xj*G of the parties with the given ids lie on a polynomial of
+// the given degree whose value at 0 is the public key, so that any threshold+1 of them interpolate to the public key
+func CheckPublicShares(ec elliptic.Curve, threshold int, ids []*big.Int, bigXs []*crypto.ECPoint, pub *crypto.ECPoint) error {
+	if threshold < 0 || len(ids) <= threshold {
+		return ErrNumSharesBelowThreshold
+	}
+	if len(ids) != len(bigXs) {
+		return errors.New("the number of public shares does not match the number of ids")
+	}
+	if _, err := CheckIndexes(ec, ids); err != nil {
+		return err
+	}
+	// the shares are checked on copies that are on ec, so that the points of the caller are left as they are
+	points := make([]*crypto.ECPoint, len(bigXs))
+	for j, bigXj := range bigXs {
+		if bigXj != nil {
+			point := *bigXj
+			points[j] = point.SetCurve(ec)
+		}
+		if !points[j].ValidateBasic() {
+			return fmt.Errorf("the public share %d is not a valid point", j)
+		}
+	}
+	// the first threshold+1 public shares determine the polynomial; every other share and the public key must be on it
+	basis, basisXs := ids[:threshold+1], points[:threshold+1]
+	if y, err := interpolatePoint(ec, basis, basisXs, zero); err != nil || !y.Equals(pub) {
+		return errors.New("the public shares do not interpolate to the public key")
+	}
+	for j := threshold + 1; j < len(ids); j++ {
+		if bigXj, err := interpolatePoint(ec, basis, basisXs, ids[j]); err != nil || !bigXj.Equals(points[j]) {
+			return fmt.Errorf("the public share %d is not on the polynomial of the other public shares", j)
+		}
+	}
+	return nil
+}
+
+// interpolatePoint evaluates at x the polynomial in the exponent through the points (ids[i], bigXs[i]), which must be
+// on ec; x must not be one of the ids
+func interpolatePoint(ec elliptic.Curve, ids []*big.Int, bigXs []*crypto.ECPoint, x *big.Int) (*crypto.ECPoint, error) {
+	modN := common.ModInt(ec.Params().N)
+	var result *crypto.ECPoint
+	for i, id := range ids {
+		// the lagrange coefficient of the i-th point at x
+		lambda := one
+		for j, idj := range ids {
+			if j == i {
+				continue
+			}
+			num := modN.Sub(x, idj)
+			den := modN.ModInverse(modN.Sub(id, idj))
+			lambda = modN.Mul(lambda, modN.Mul(num, den))
+		}
+		term := bigXs[i].ScalarMult(lambda)
+		if result == nil {
+			result = term
+			continue
+		}
+		var err error
+		if result, err = result.Add(term); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
 func samplePolynomial(ec elliptic.Curve, threshold int, secret *big.Int, rand io.Reader) []*big.Int {
 	q := ec.Params().N
 	v := make([]*big.Int, threshold+1)
@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/SafeMPC/tss-lib/common"
+	"github.com/SafeMPC/tss-lib/crypto"
 	. "github.com/SafeMPC/tss-lib/crypto/vss"
 	"github.com/SafeMPC/tss-lib/tss"
 )
@@ -21,40 +22,40 @@ import (
 func TestCheckIndexesDup(t *testing.T) {
 	indexes := make([]*big.Int, 0)
 	for i := 0; i < 1000; i++ {
-		indexes = append(indexes, common.GetRandomPositiveInt(rand.Reader, tss.EC().Params().N))
+		indexes = append(indexes, common.GetRandomPositiveInt(rand.Reader, tss.S256().Params().N))
 	}
-	_, e := CheckIndexes(tss.EC(), indexes)
+	_, e := CheckIndexes(tss.S256(), indexes)
 	assert.NoError(t, e)
 
 	indexes = append(indexes, indexes[99])
-	_, e = CheckIndexes(tss.EC(), indexes)
+	_, e = CheckIndexes(tss.S256(), indexes)
 	assert.Error(t, e)
 }
 
 func TestCheckIndexesZero(t *testing.T) {
 	indexes := make([]*big.Int, 0)
 	for i := 0; i < 1000; i++ {
-		indexes = append(indexes, common.GetRandomPositiveInt(rand.Reader, tss.EC().Params().N))
+		indexes = append(indexes, common.GetRandomPositiveInt(rand.Reader, tss.S256().Params().N))
 	}
-	_, e := CheckIndexes(tss.EC(), indexes)
+	_, e := CheckIndexes(tss.S256(), indexes)
 	assert.NoError(t, e)
 
-	indexes = append(indexes, tss.EC().Params().N)
-	_, e = CheckIndexes(tss.EC(), indexes)
+	indexes = append(indexes, tss.S256().Params().N)
+	_, e = CheckIndexes(tss.S256(), indexes)
 	assert.Error(t, e)
 }
 
 func TestCreate(t *testing.T) {
 	num, threshold := 5, 3
 
-	secret := common.GetRandomPositiveInt(rand.Reader, tss.EC().Params().N)
+	secret := common.GetRandomPositiveInt(rand.Reader, tss.S256().Params().N)
 
 	ids := make([]*big.Int, 0)
 	for i := 0; i < num; i++ {
-		ids = append(ids, common.GetRandomPositiveInt(rand.Reader, tss.EC().Params().N))
+		ids = append(ids, common.GetRandomPositiveInt(rand.Reader, tss.S256().Params().N))
 	}
 
-	vs, _, err := Create(tss.EC(), threshold, secret, ids, rand.Reader)
+	vs, _, err := Create(tss.S256(), threshold, secret, ids, rand.Reader)
 	assert.Nil(t, err)
 
 	assert.Equal(t, threshold+1, len(vs))
@@ -75,43 +76,85 @@ func TestCreate(t *testing.T) {
 func TestVerify(t *testing.T) {
 	num, threshold := 5, 3
 
-	secret := common.GetRandomPositiveInt(rand.Reader, tss.EC().Params().N)
+	secret := common.GetRandomPositiveInt(rand.Reader, tss.S256().Params().N)
 
 	ids := make([]*big.Int, 0)
 	for i := 0; i < num; i++ {
-		ids = append(ids, common.GetRandomPositiveInt(rand.Reader, tss.EC().Params().N))
+		ids = append(ids, common.GetRandomPositiveInt(rand.Reader, tss.S256().Params().N))
 	}
 
-	vs, shares, err := Create(tss.EC(), threshold, secret, ids, rand.Reader)
+	vs, shares, err := Create(tss.S256(), threshold, secret, ids, rand.Reader)
 	assert.NoError(t, err)
 
 	for i := 0; i < num; i++ {
-		assert.True(t, shares[i].Verify(tss.EC(), threshold, vs))
+		assert.True(t, shares[i].Verify(tss.S256(), threshold, vs))
 	}
+
+	shares[0].Share = new(big.Int).Add(shares[0].Share, tss.S256().Params().N)
+	assert.False(t, shares[0].Verify(tss.S256(), threshold, vs), "a share that is not less than q must be rejected")
 }
 
 func TestReconstruct(t *testing.T) {
 	num, threshold := 5, 3
 
-	secret := common.GetRandomPositiveInt(rand.Reader, tss.EC().Params().N)
+	secret := common.GetRandomPositiveInt(rand.Reader, tss.S256().Params().N)
 
 	ids := make([]*big.Int, 0)
 	for i := 0; i < num; i++ {
-		ids = append(ids, common.GetRandomPositiveInt(rand.Reader, tss.EC().Params().N))
+		ids = append(ids, common.GetRandomPositiveInt(rand.Reader, tss.S256().Params().N))
 	}
 
-	_, shares, err := Create(tss.EC(), threshold, secret, ids, rand.Reader)
+	_, shares, err := Create(tss.S256(), threshold, secret, ids, rand.Reader)
 	assert.NoError(t, err)
 
-	secret2, err2 := shares[:threshold-1].ReConstruct(tss.EC())
+	secret2, err2 := shares[:threshold-1].ReConstruct(tss.S256())
 	assert.Error(t, err2) // not enough shares to satisfy the threshold
 	assert.Nil(t, secret2)
 
-	secret3, err3 := shares[:threshold].ReConstruct(tss.EC())
+	secret3, err3 := shares[:threshold].ReConstruct(tss.S256())
 	assert.NoError(t, err3)
 	assert.NotZero(t, secret3)
 
-	secret4, err4 := shares[:num].ReConstruct(tss.EC())
+	secret4, err4 := shares[:num].ReConstruct(tss.S256())
 	assert.NoError(t, err4)
 	assert.NotZero(t, secret4)
 }
+
+func TestCheckPublicShares(t *testing.T) {
+	num, threshold := 5, 3
+	ec := tss.S256()
+
+	secret := common.GetRandomPositiveInt(rand.Reader, ec.Params().N)
+	ids := make([]*big.Int, 0)
+	for i := 0; i < num; i++ {
+		ids = append(ids, common.GetRandomPositiveInt(rand.Reader, ec.Params().N))
+	}
+	_, shares, err := Create(ec, threshold, secret, ids, rand.Reader)
+	assert.NoError(t, err)
+	bigXs := make([]*crypto.ECPoint, num)
+	for i, share := range shares {
+		bigXs[i] = crypto.ScalarBaseMult(ec, share.Share)
+	}
+	pub := crypto.ScalarBaseMult(ec, secret)
+	assert.NoError(t, CheckPublicShares(ec, threshold, ids, bigXs, pub))
+
+	// the shares are of degree 3, so they are not on a polynomial of a lower degree
+	assert.Error(t, CheckPublicShares(ec, threshold-1, ids, bigXs, pub))
+	assert.Error(t, CheckPublicShares(ec, num, ids, bigXs, pub))
+	assert.Error(t, CheckPublicShares(ec, threshold, ids, bigXs, crypto.ScalarBaseMult(ec, big.NewInt(1))))
+
+	// a public share that is off the polynomial
+	tampered := append([]*crypto.ECPoint(nil), bigXs...)
+	tampered[num-1] = crypto.ScalarBaseMult(ec, big.NewInt(1))
+	assert.Error(t, CheckPublicShares(ec, threshold, ids, tampered, pub))
+
+	// points without a curve, as they are decoded, are checked on ec but not changed
+	decoded := make([]*crypto.ECPoint, num)
+	for i, bigX := range bigXs {
+		decoded[i] = crypto.NewECPointNoCurveCheck(nil, bigX.X(), bigX.Y())
+	}
+	assert.NoError(t, CheckPublicShares(ec, threshold, ids, decoded, pub))
+	for _, bigX := range decoded {
+		assert.Nil(t, bigX.Curve())
+	}
+}
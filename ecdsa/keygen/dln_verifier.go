@@ -10,11 +10,13 @@ import (
 	"errors"
 	"math/big"
 
+	"github.com/SafeMPC/tss-lib/common"
 	"github.com/SafeMPC/tss-lib/crypto/dlnproof"
 )
 
 type DlnProofVerifier struct {
 	semaphore chan interface{}
+	executor  common.Executor
 }
 
 type message interface {
@@ -22,15 +24,25 @@ type message interface {
 	UnmarshalDLNProof2() (*dlnproof.Proof, error)
 }
 
-func NewDlnProofVerifier(concurrency int) *DlnProofVerifier {
+// NewDlnProofVerifier creates a verifier that verifies up to `concurrency` proofs at once, on the given executor or
+// on goroutines of its own
+func NewDlnProofVerifier(concurrency int, optionalExecutor ...common.Executor) *DlnProofVerifier {
 	if concurrency == 0 {
 		panic(errors.New("NewDlnProofverifier: concurrency level must not be zero"))
 	}
+	if 1 < len(optionalExecutor) {
+		panic(errors.New("NewDlnProofverifier: expected 0 or 1 item in `optionalExecutor`"))
+	}
 
 	semaphore := make(chan interface{}, concurrency)
+	executor := common.GoExecutor
+	if len(optionalExecutor) == 1 {
+		executor = optionalExecutor[0]
+	}
 
 	return &DlnProofVerifier{
 		semaphore: semaphore,
+		executor:  executor,
 	}
 }
 
@@ -40,7 +52,7 @@ func (dpv *DlnProofVerifier) VerifyDLNProof1(
 	onDone func(bool),
 ) {
 	dpv.semaphore <- struct{}{}
-	go func() {
+	dpv.executor.Go(func() {
 		defer func() { <-dpv.semaphore }()
 
 		dlnProof, err := m.UnmarshalDLNProof1()
@@ -50,7 +62,7 @@ func (dpv *DlnProofVerifier) VerifyDLNProof1(
 		}
 
 		onDone(dlnProof.Verify(h1, h2, n))
-	}()
+	})
 }
 
 func (dpv *DlnProofVerifier) VerifyDLNProof2(
@@ -59,7 +71,7 @@ func (dpv *DlnProofVerifier) VerifyDLNProof2(
 	onDone func(bool),
 ) {
 	dpv.semaphore <- struct{}{}
-	go func() {
+	dpv.executor.Go(func() {
 		defer func() { <-dpv.semaphore }()
 
 		dlnProof, err := m.UnmarshalDLNProof2()
@@ -69,5 +81,5 @@ func (dpv *DlnProofVerifier) VerifyDLNProof2(
 		}
 
 		onDone(dlnProof.Verify(h1, h2, n))
-	}()
+	})
 }
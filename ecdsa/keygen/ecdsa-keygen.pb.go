@@ -6,8 +6,8 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.31.0
-// 	protoc        v3.20.3
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.14.0
 // source: protob/ecdsa-keygen.proto
 
 package keygen
@@ -17,6 +17,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -28,26 +29,23 @@ const (
 
 // Represents a BROADCAST message sent during Round 1 of the ECDSA TSS keygen protocol.
 type KGRound1Message struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Commitment    []byte                 `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	PaillierN     []byte                 `protobuf:"bytes,2,opt,name=paillier_n,json=paillierN,proto3" json:"paillier_n,omitempty"`
+	NTilde        []byte                 `protobuf:"bytes,3,opt,name=n_tilde,json=nTilde,proto3" json:"n_tilde,omitempty"`
+	H1            []byte                 `protobuf:"bytes,4,opt,name=h1,proto3" json:"h1,omitempty"`
+	H2            []byte                 `protobuf:"bytes,5,opt,name=h2,proto3" json:"h2,omitempty"`
+	Dlnproof_1    [][]byte               `protobuf:"bytes,6,rep,name=dlnproof_1,json=dlnproof1,proto3" json:"dlnproof_1,omitempty"`
+	Dlnproof_2    [][]byte               `protobuf:"bytes,7,rep,name=dlnproof_2,json=dlnproof2,proto3" json:"dlnproof_2,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Commitment []byte   `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
-	PaillierN  []byte   `protobuf:"bytes,2,opt,name=paillier_n,json=paillierN,proto3" json:"paillier_n,omitempty"`
-	NTilde     []byte   `protobuf:"bytes,3,opt,name=n_tilde,json=nTilde,proto3" json:"n_tilde,omitempty"`
-	H1         []byte   `protobuf:"bytes,4,opt,name=h1,proto3" json:"h1,omitempty"`
-	H2         []byte   `protobuf:"bytes,5,opt,name=h2,proto3" json:"h2,omitempty"`
-	Dlnproof_1 [][]byte `protobuf:"bytes,6,rep,name=dlnproof_1,json=dlnproof1,proto3" json:"dlnproof_1,omitempty"`
-	Dlnproof_2 [][]byte `protobuf:"bytes,7,rep,name=dlnproof_2,json=dlnproof2,proto3" json:"dlnproof_2,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *KGRound1Message) Reset() {
 	*x = KGRound1Message{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protob_ecdsa_keygen_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_protob_ecdsa_keygen_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *KGRound1Message) String() string {
@@ -58,7 +56,7 @@ func (*KGRound1Message) ProtoMessage() {}
 
 func (x *KGRound1Message) ProtoReflect() protoreflect.Message {
 	mi := &file_protob_ecdsa_keygen_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -124,21 +122,18 @@ func (x *KGRound1Message) GetDlnproof_2() [][]byte {
 
 // Represents a P2P message sent to each party during Round 2 of the ECDSA TSS keygen protocol.
 type KGRound2Message1 struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Share         []byte                 `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
+	FacProof      [][]byte               `protobuf:"bytes,2,rep,name=facProof,proto3" json:"facProof,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Share    []byte   `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
-	FacProof [][]byte `protobuf:"bytes,2,rep,name=facProof,proto3" json:"facProof,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *KGRound2Message1) Reset() {
 	*x = KGRound2Message1{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protob_ecdsa_keygen_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_protob_ecdsa_keygen_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *KGRound2Message1) String() string {
@@ -149,7 +144,7 @@ func (*KGRound2Message1) ProtoMessage() {}
 
 func (x *KGRound2Message1) ProtoReflect() protoreflect.Message {
 	mi := &file_protob_ecdsa_keygen_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -180,21 +175,18 @@ func (x *KGRound2Message1) GetFacProof() [][]byte {
 
 // Represents a BROADCAST message sent to each party during Round 2 of the ECDSA TSS keygen protocol.
 type KGRound2Message2 struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeCommitment  [][]byte               `protobuf:"bytes,1,rep,name=de_commitment,json=deCommitment,proto3" json:"de_commitment,omitempty"`
+	ModProof      [][]byte               `protobuf:"bytes,2,rep,name=modProof,proto3" json:"modProof,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	DeCommitment [][]byte `protobuf:"bytes,1,rep,name=de_commitment,json=deCommitment,proto3" json:"de_commitment,omitempty"`
-	ModProof     [][]byte `protobuf:"bytes,2,rep,name=modProof,proto3" json:"modProof,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *KGRound2Message2) Reset() {
 	*x = KGRound2Message2{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protob_ecdsa_keygen_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_protob_ecdsa_keygen_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *KGRound2Message2) String() string {
@@ -205,7 +197,7 @@ func (*KGRound2Message2) ProtoMessage() {}
 
 func (x *KGRound2Message2) ProtoReflect() protoreflect.Message {
 	mi := &file_protob_ecdsa_keygen_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -236,20 +228,19 @@ func (x *KGRound2Message2) GetModProof() [][]byte {
 
 // Represents a BROADCAST message sent to each party during Round 3 of the ECDSA TSS keygen protocol.
 type KGRound3Message struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaillierProof [][]byte               `protobuf:"bytes,1,rep,name=paillier_proof,json=paillierProof,proto3" json:"paillier_proof,omitempty"`
+	// the indices of the dealers whose shares failed verification, with complaints enabled
+	Complaints    []uint32 `protobuf:"varint,2,rep,packed,name=complaints,proto3" json:"complaints,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	PaillierProof [][]byte `protobuf:"bytes,1,rep,name=paillier_proof,json=paillierProof,proto3" json:"paillier_proof,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *KGRound3Message) Reset() {
 	*x = KGRound3Message{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protob_ecdsa_keygen_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_protob_ecdsa_keygen_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *KGRound3Message) String() string {
@@ -260,7 +251,7 @@ func (*KGRound3Message) ProtoMessage() {}
 
 func (x *KGRound3Message) ProtoReflect() protoreflect.Message {
 	mi := &file_protob_ecdsa_keygen_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -282,60 +273,119 @@ func (x *KGRound3Message) GetPaillierProof() [][]byte {
 	return nil
 }
 
-var File_protob_ecdsa_keygen_proto protoreflect.FileDescriptor
+func (x *KGRound3Message) GetComplaints() []uint32 {
+	if x != nil {
+		return x.Complaints
+	}
+	return nil
+}
 
-var file_protob_ecdsa_keygen_proto_rawDesc = []byte{
-	0x0a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2f, 0x65, 0x63, 0x64, 0x73, 0x61, 0x2d, 0x6b,
-	0x65, 0x79, 0x67, 0x65, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x62, 0x69, 0x6e,
-	0x61, 0x6e, 0x63, 0x65, 0x2e, 0x74, 0x73, 0x73, 0x6c, 0x69, 0x62, 0x2e, 0x65, 0x63, 0x64, 0x73,
-	0x61, 0x2e, 0x6b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x22, 0xc7, 0x01, 0x0a, 0x0f, 0x4b, 0x47, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x31, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
-	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x70, 0x61, 0x69, 0x6c, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x09, 0x70, 0x61, 0x69, 0x6c, 0x6c, 0x69, 0x65, 0x72, 0x4e, 0x12, 0x17, 0x0a, 0x07, 0x6e,
-	0x5f, 0x74, 0x69, 0x6c, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6e, 0x54,
-	0x69, 0x6c, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x68, 0x31, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x02, 0x68, 0x31, 0x12, 0x0e, 0x0a, 0x02, 0x68, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x02, 0x68, 0x32, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x6c, 0x6e, 0x70, 0x72, 0x6f, 0x6f, 0x66,
-	0x5f, 0x31, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x6c, 0x6e, 0x70, 0x72, 0x6f,
-	0x6f, 0x66, 0x31, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x6c, 0x6e, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f,
-	0x32, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x6c, 0x6e, 0x70, 0x72, 0x6f, 0x6f,
-	0x66, 0x32, 0x22, 0x44, 0x0a, 0x10, 0x4b, 0x47, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x32, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x31, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x66, 0x61, 0x63, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08,
-	0x66, 0x61, 0x63, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x53, 0x0a, 0x10, 0x4b, 0x47, 0x52, 0x6f,
-	0x75, 0x6e, 0x64, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0x23, 0x0a, 0x0d,
-	0x64, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0c, 0x52, 0x0c, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x38, 0x0a,
-	0x0f, 0x4b, 0x47, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x33, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x69, 0x6c, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f,
-	0x6f, 0x66, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0d, 0x70, 0x61, 0x69, 0x6c, 0x6c, 0x69,
-	0x65, 0x72, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x0e, 0x5a, 0x0c, 0x65, 0x63, 0x64, 0x73, 0x61,
-	0x2f, 0x6b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+// Represents a BROADCAST message sent during Round 4 of the ECDSA TSS keygen protocol by a dealer that was accused in
+// a complaint; it reveals the disputed share of each accuser.
+type KGRound4Message struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accusers      []uint32               `protobuf:"varint,1,rep,packed,name=accusers,proto3" json:"accusers,omitempty"`
+	Shares        [][]byte               `protobuf:"bytes,2,rep,name=shares,proto3" json:"shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
+func (x *KGRound4Message) Reset() {
+	*x = KGRound4Message{}
+	mi := &file_protob_ecdsa_keygen_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KGRound4Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KGRound4Message) ProtoMessage() {}
+
+func (x *KGRound4Message) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_ecdsa_keygen_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KGRound4Message.ProtoReflect.Descriptor instead.
+func (*KGRound4Message) Descriptor() ([]byte, []int) {
+	return file_protob_ecdsa_keygen_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *KGRound4Message) GetAccusers() []uint32 {
+	if x != nil {
+		return x.Accusers
+	}
+	return nil
+}
+
+func (x *KGRound4Message) GetShares() [][]byte {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+var File_protob_ecdsa_keygen_proto protoreflect.FileDescriptor
+
+const file_protob_ecdsa_keygen_proto_rawDesc = "" +
+	"\n" +
+	"\x19protob/ecdsa-keygen.proto\x12\x1bSafeMPC.tsslib.ecdsa.keygen\"\xc7\x01\n" +
+	"\x0fKGRound1Message\x12\x1e\n" +
+	"\n" +
+	"commitment\x18\x01 \x01(\fR\n" +
+	"commitment\x12\x1d\n" +
+	"\n" +
+	"paillier_n\x18\x02 \x01(\fR\tpaillierN\x12\x17\n" +
+	"\an_tilde\x18\x03 \x01(\fR\x06nTilde\x12\x0e\n" +
+	"\x02h1\x18\x04 \x01(\fR\x02h1\x12\x0e\n" +
+	"\x02h2\x18\x05 \x01(\fR\x02h2\x12\x1d\n" +
+	"\n" +
+	"dlnproof_1\x18\x06 \x03(\fR\tdlnproof1\x12\x1d\n" +
+	"\n" +
+	"dlnproof_2\x18\a \x03(\fR\tdlnproof2\"D\n" +
+	"\x10KGRound2Message1\x12\x14\n" +
+	"\x05share\x18\x01 \x01(\fR\x05share\x12\x1a\n" +
+	"\bfacProof\x18\x02 \x03(\fR\bfacProof\"S\n" +
+	"\x10KGRound2Message2\x12#\n" +
+	"\rde_commitment\x18\x01 \x03(\fR\fdeCommitment\x12\x1a\n" +
+	"\bmodProof\x18\x02 \x03(\fR\bmodProof\"X\n" +
+	"\x0fKGRound3Message\x12%\n" +
+	"\x0epaillier_proof\x18\x01 \x03(\fR\rpaillierProof\x12\x1e\n" +
+	"\n" +
+	"complaints\x18\x02 \x03(\rR\n" +
+	"complaints\"E\n" +
+	"\x0fKGRound4Message\x12\x1a\n" +
+	"\baccusers\x18\x01 \x03(\rR\baccusers\x12\x16\n" +
+	"\x06shares\x18\x02 \x03(\fR\x06sharesB\x0eZ\fecdsa/keygenb\x06proto3"
+
 var (
 	file_protob_ecdsa_keygen_proto_rawDescOnce sync.Once
-	file_protob_ecdsa_keygen_proto_rawDescData = file_protob_ecdsa_keygen_proto_rawDesc
+	file_protob_ecdsa_keygen_proto_rawDescData []byte
 )
 
 func file_protob_ecdsa_keygen_proto_rawDescGZIP() []byte {
 	file_protob_ecdsa_keygen_proto_rawDescOnce.Do(func() {
-		file_protob_ecdsa_keygen_proto_rawDescData = protoimpl.X.CompressGZIP(file_protob_ecdsa_keygen_proto_rawDescData)
+		file_protob_ecdsa_keygen_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_protob_ecdsa_keygen_proto_rawDesc), len(file_protob_ecdsa_keygen_proto_rawDesc)))
 	})
 	return file_protob_ecdsa_keygen_proto_rawDescData
 }
 
-var file_protob_ecdsa_keygen_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
-var file_protob_ecdsa_keygen_proto_goTypes = []interface{}{
+var file_protob_ecdsa_keygen_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_protob_ecdsa_keygen_proto_goTypes = []any{
 	(*KGRound1Message)(nil),  // 0: SafeMPC.tsslib.ecdsa.keygen.KGRound1Message
 	(*KGRound2Message1)(nil), // 1: SafeMPC.tsslib.ecdsa.keygen.KGRound2Message1
 	(*KGRound2Message2)(nil), // 2: SafeMPC.tsslib.ecdsa.keygen.KGRound2Message2
 	(*KGRound3Message)(nil),  // 3: SafeMPC.tsslib.ecdsa.keygen.KGRound3Message
+	(*KGRound4Message)(nil),  // 4: SafeMPC.tsslib.ecdsa.keygen.KGRound4Message
 }
 var file_protob_ecdsa_keygen_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -350,63 +400,13 @@ func file_protob_ecdsa_keygen_proto_init() {
 	if File_protob_ecdsa_keygen_proto != nil {
 		return
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_protob_ecdsa_keygen_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KGRound1Message); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_protob_ecdsa_keygen_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KGRound2Message1); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_protob_ecdsa_keygen_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KGRound2Message2); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_protob_ecdsa_keygen_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KGRound3Message); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_protob_ecdsa_keygen_proto_rawDesc,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_protob_ecdsa_keygen_proto_rawDesc), len(file_protob_ecdsa_keygen_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -415,7 +415,6 @@ func file_protob_ecdsa_keygen_proto_init() {
 		MessageInfos:      file_protob_ecdsa_keygen_proto_msgTypes,
 	}.Build()
 	File_protob_ecdsa_keygen_proto = out.File
-	file_protob_ecdsa_keygen_proto_rawDesc = nil
 	file_protob_ecdsa_keygen_proto_goTypes = nil
 	file_protob_ecdsa_keygen_proto_depIdxs = nil
 }
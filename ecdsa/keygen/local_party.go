@@ -7,6 +7,7 @@
 package keygen
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -41,7 +42,8 @@ type (
 		kgRound1Messages,
 		kgRound2Message1s,
 		kgRound2Message2s,
-		kgRound3Messages []tss.ParsedMessage
+		kgRound3Messages,
+		kgRound4Messages []tss.ParsedMessage
 	}
 
 	localTempData struct {
@@ -55,6 +57,16 @@ type (
 		ssidNonce     *big.Int
 		shares        vss.Shares
 		deCommitPolyG cmt.HashDeCommitment
+		// the polynomial commitments of each dealer and the complaints of all parties, with complaints enabled
+		dealerVs   []vss.Vs
+		complaints []complaint
+		// the accusers whose complaints were resolved by a valid revealed share
+		falseComplaints *tss.Error
+	}
+
+	// complaint is an accusation that the dealer sent the accuser a share that failed verification
+	complaint struct {
+		Accuser, Dealer int
 	}
 )
 
@@ -78,7 +90,7 @@ func NewLocalParty(
 		data.LocalPreParams = optionalPreParams[0]
 	}
 	p := &LocalParty{
-		BaseParty: new(tss.BaseParty),
+		BaseParty: tss.NewBaseParty(out),
 		params:    params,
 		temp:      localTempData{},
 		data:      data,
@@ -90,11 +102,32 @@ func NewLocalParty(
 	p.temp.kgRound2Message1s = make([]tss.ParsedMessage, partyCount)
 	p.temp.kgRound2Message2s = make([]tss.ParsedMessage, partyCount)
 	p.temp.kgRound3Messages = make([]tss.ParsedMessage, partyCount)
+	p.temp.kgRound4Messages = make([]tss.ParsedMessage, partyCount)
 	// temp data init
 	p.temp.KGCs = make([]cmt.HashCommitment, partyCount)
 	return p
 }
 
+// NewLocalPartyFromPool creates a party that uses pre-parameters taken from the pool, waiting for the pool until ctx is
+// done if it is empty
+func NewLocalPartyFromPool(
+	ctx context.Context,
+	pool *PreParamsPool,
+	params *tss.Parameters,
+	out chan<- tss.Message,
+	end chan<- *LocalPartySaveData,
+) (tss.Party, error) {
+	if pool.ModulusBits() != params.ModulusBits() {
+		return nil, fmt.Errorf("the pool has pre-parameters with moduli of %d bits, but the party needs %d bits",
+			pool.ModulusBits(), params.ModulusBits())
+	}
+	preParams, err := pool.Take(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return NewLocalParty(params, out, end, *preParams), nil
+}
+
 func (p *LocalParty) FirstRound() tss.Round {
 	return newRound1(p.params, &p.data, &p.temp, p.out, p.end)
 }
@@ -107,10 +140,18 @@ func (p *LocalParty) Update(msg tss.ParsedMessage) (ok bool, err *tss.Error) {
 	return tss.BaseUpdate(p, msg, TaskName)
 }
 
+// Abort stops the party, overwrites the secrets in its temp data and drops the messages that it received
+func (p *LocalParty) Abort(reason error) *tss.Error {
+	return tss.BaseAbort(p, TaskName, reason, func() {
+		p.temp.zeroize()
+		p.temp.localMessageStore = localMessageStore{}
+	})
+}
+
 func (p *LocalParty) UpdateFromBytes(wireBytes []byte, from *tss.PartyID, isBroadcast bool) (bool, *tss.Error) {
-	msg, err := tss.ParseWireMessage(wireBytes, from, isBroadcast)
+	msg, err := p.params.ParseWireMessage(wireBytes, from, isBroadcast)
 	if err != nil {
-		return false, p.WrapError(err)
+		return false, tss.WrapParseError(p, err, wireBytes, from, isBroadcast)
 	}
 	return p.Update(msg)
 }
@@ -121,8 +162,8 @@ func (p *LocalParty) ValidateMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	}
 	// check that the message's "from index" will fit into the array
 	if maxFromIdx := p.params.PartyCount() - 1; maxFromIdx < msg.GetFrom().Index {
-		return false, p.WrapError(fmt.Errorf("received msg with a sender index too great (%d <= %d)",
-			p.params.PartyCount(), msg.GetFrom().Index), msg.GetFrom())
+		return false, p.WrapError(tss.Classify(fmt.Errorf("received msg with a sender index too great (%d <= %d)",
+			p.params.PartyCount(), msg.GetFrom().Index), tss.ErrBadMessage), msg.GetFrom()).WithMessages(msg)
 	}
 	return true, nil
 }
@@ -135,21 +176,24 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	fromPIdx := msg.GetFrom().Index
 
 	// switch/case is necessary to store any messages beyond current round
-	// this does not handle message replays. we expect the caller to apply replay and spoofing protection.
+	// a repeated message from the same sender is ignored if identical and rejected as equivocation otherwise.
+	var slot *tss.ParsedMessage
 	switch msg.Content().(type) {
 	case *KGRound1Message:
-		p.temp.kgRound1Messages[fromPIdx] = msg
+		slot = &p.temp.kgRound1Messages[fromPIdx]
 	case *KGRound2Message1:
-		p.temp.kgRound2Message1s[fromPIdx] = msg
+		slot = &p.temp.kgRound2Message1s[fromPIdx]
 	case *KGRound2Message2:
-		p.temp.kgRound2Message2s[fromPIdx] = msg
+		slot = &p.temp.kgRound2Message2s[fromPIdx]
 	case *KGRound3Message:
-		p.temp.kgRound3Messages[fromPIdx] = msg
+		slot = &p.temp.kgRound3Messages[fromPIdx]
+	case *KGRound4Message:
+		slot = &p.temp.kgRound4Messages[fromPIdx]
 	default: // unrecognised message, just ignore!
-		common.Logger.Warningf("unrecognised message ignored: %v", msg)
+		p.params.Logger().Warn("unrecognised message ignored", "task", TaskName, "msg", msg.String())
 		return false, nil
 	}
-	return true, nil
+	return tss.StoreMessageOnce(p, slot, msg)
 }
 
 // recovers a party's original index in the set of parties during keygen
@@ -169,6 +213,14 @@ func (save LocalPartySaveData) OriginalIndex() (int, error) {
 	return index, nil
 }
 
+// FalseComplaints returns an error naming the parties whose complaints were resolved by a valid revealed share, or nil
+// if there were none. Keygen completes in that case, but the caller may exclude the accusers and run it again. It is
+// set before the save data is sent to the end channel, and every honest party names the same accusers. Note that a
+// dealer that dealt an invalid share privately but revealed a valid one cannot be told apart from a false accuser.
+func (p *LocalParty) FalseComplaints() *tss.Error {
+	return p.temp.falseComplaints
+}
+
 func (p *LocalParty) PartyID() *tss.PartyID {
 	return p.params.PartyID()
 }
@@ -176,3 +228,11 @@ func (p *LocalParty) PartyID() *tss.PartyID {
 func (p *LocalParty) String() string {
 	return fmt.Sprintf("id: %s, %s", p.PartyID(), p.BaseParty.String())
 }
+
+// zeroize overwrites the secrets in the temp data; the party does so itself once it has output its result
+func (temp *localTempData) zeroize() {
+	common.Zeroize(temp.ui)
+	for _, share := range temp.shares {
+		common.Zeroize(share.Share)
+	}
+}
@@ -7,15 +7,19 @@
 package keygen
 
 import (
+	"context"
 	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
 	"encoding/json"
 	"fmt"
 	"math/big"
 	"os"
 	"runtime"
+	"slices"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/ipfs/go-log"
 	"github.com/stretchr/testify/assert"
@@ -27,6 +31,8 @@ import (
 	"github.com/SafeMPC/tss-lib/crypto/vss"
 	"github.com/SafeMPC/tss-lib/test"
 	"github.com/SafeMPC/tss-lib/tss"
+	"github.com/SafeMPC/tss-lib/tss/transport"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -43,10 +49,10 @@ func setUp(level string) {
 func TestStartRound1Paillier(t *testing.T) {
 	setUp("debug")
 
-	pIDs := tss.GenerateTestPartyIDs(1)
+	pIDs := tss.GenerateTestPartyIDs(2)
 	p2pCtx := tss.NewPeerContext(pIDs)
 	threshold := 1
-	params := tss.NewParameters(tss.EC(), p2pCtx, pIDs[0], len(pIDs), threshold)
+	params := tss.NewParameters(tss.S256(), p2pCtx, pIDs[0], len(pIDs), threshold)
 
 	fixtures, pIDs, err := LoadKeygenTestFixtures(testParticipants)
 	if err != nil {
@@ -80,13 +86,42 @@ func TestStartRound1Paillier(t *testing.T) {
 	assert.Equal(t, 2048/8, len2)
 }
 
+func TestModulusBits(t *testing.T) {
+	fixtures, pIDs, err := LoadKeygenTestFixtures(testParticipants)
+	if err != nil {
+		t.Skip("the test fixtures were not found; run the keygen tests first")
+	}
+	key := fixtures[0]
+	assert.Equal(t, tss.DefaultModulusBits, key.ModulusBitLen(), "save data without a modulus size has the default")
+	j, err := key.ValidateModuli()
+	assert.Equal(t, -1, j)
+	assert.NoError(t, err)
+	assert.True(t, key.LocalPreParams.HasModulusBits(2048))
+
+	// the peers are validated against the recorded size
+	key.ModulusBits = 3072
+	j, err = key.ValidateModuli()
+	assert.Equal(t, 0, j)
+	assert.Error(t, err)
+
+	// pre-params that do not have the size of the parameters are rejected
+	params := tss.NewParameters(tss.S256(), tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), testThreshold)
+	params.SetModulusBits(3072)
+	lp := NewLocalParty(params, make(chan tss.Message, len(pIDs)), nil, key.LocalPreParams)
+	if tErr := lp.Start(); assert.NotNil(t, tErr) {
+		assert.ErrorIs(t, tErr, tss.ErrInvalidParameters)
+	}
+	_, err = NewLocalPartyFromPool(context.Background(), NewPreParamsPool(NewMemoryPreParamsStore(), 1), params, nil, nil)
+	assert.Error(t, err, "the pool has pre-params of another size")
+}
+
 func TestFinishAndSaveH1H2(t *testing.T) {
 	setUp("debug")
 
-	pIDs := tss.GenerateTestPartyIDs(1)
+	pIDs := tss.GenerateTestPartyIDs(2)
 	p2pCtx := tss.NewPeerContext(pIDs)
 	threshold := 1
-	params := tss.NewParameters(tss.EC(), p2pCtx, pIDs[0], len(pIDs), threshold)
+	params := tss.NewParameters(tss.S256(), p2pCtx, pIDs[0], len(pIDs), threshold)
 
 	fixtures, pIDs, err := LoadKeygenTestFixtures(testParticipants)
 	if err != nil {
@@ -167,12 +202,22 @@ func TestBadMessageCulprits(t *testing.T) {
 }
 
 func TestE2EConcurrentAndSaveFixtures(t *testing.T) {
-	setUp("info")
+	testE2EConcurrentAndSaveFixtures(t, tss.S256())
+}
 
-	// tss.SetCurve(elliptic.P256())
+func TestE2EConcurrentAndSaveFixturesP256(t *testing.T) {
+	testE2EConcurrentAndSaveFixtures(t, tss.P256())
+}
+
+func testE2EConcurrentAndSaveFixtures(t *testing.T, curve elliptic.Curve) {
+	setUp("info")
 
 	threshold := testThreshold
-	fixtures, pIDs, err := LoadKeygenTestFixtures(testParticipants)
+	fixtures, pIDs, err := LoadKeygenTestFixturesForCurve(curve, testParticipants)
+	if err != nil && !tss.SameCurve(curve, tss.S256()) {
+		// the pre-parameters do not depend on the curve, so reuse those of the secp256k1 fixtures
+		fixtures, pIDs, err = LoadKeygenTestFixtures(testParticipants)
+	}
 	if err != nil {
 		common.Logger.Info("No test fixtures were found, so the safe primes will be generated from scratch. This may take a while...")
 		pIDs = tss.GenerateTestPartyIDs(testParticipants)
@@ -185,14 +230,16 @@ func TestE2EConcurrentAndSaveFixtures(t *testing.T) {
 	outCh := make(chan tss.Message, len(pIDs))
 	endCh := make(chan *LocalPartySaveData, len(pIDs))
 
-	updater := test.SharedPartyUpdater
+	hub := transport.NewHub()
+	defer hub.Close()
+	updater := test.SharedTransportUpdater
 
 	startGR := runtime.NumGoroutine()
 
 	// init the parties
 	for i := 0; i < len(pIDs); i++ {
 		var P *LocalParty
-		params := tss.NewParameters(tss.S256(), p2pCtx, pIDs[i], len(pIDs), threshold)
+		params := tss.NewParameters(curve, p2pCtx, pIDs[i], len(pIDs), threshold)
 		// do not use in untrusted setting
 		params.SetNoProofMod()
 		// do not use in untrusted setting
@@ -203,6 +250,9 @@ func TestE2EConcurrentAndSaveFixtures(t *testing.T) {
 			P = NewLocalParty(params, outCh, endCh).(*LocalParty)
 		}
 		parties = append(parties, P)
+		conn, err := hub.Connect(P.PartyID())
+		assert.NoError(t, err)
+		go updater(P, conn, errCh)
 		go func(P *LocalParty) {
 			if err := P.Start(); err != nil {
 				errCh <- err
@@ -222,20 +272,8 @@ keygen:
 			break keygen
 
 		case msg := <-outCh:
-			dest := msg.GetTo()
-			if dest == nil { // broadcast!
-				for _, P := range parties {
-					if P.PartyID().Index == msg.GetFrom().Index {
-						continue
-					}
-					go updater(P, msg, errCh)
-				}
-			} else { // point-to-point!
-				if dest[0].Index == msg.GetFrom().Index {
-					t.Fatalf("party %d tried to send a message to itself (%d)", dest[0].Index, msg.GetFrom().Index)
-					return
-				}
-				go updater(parties[dest[0].Index], msg, errCh)
+			if err := hub.Send(msg); err != nil {
+				assert.FailNow(t, err.Error())
 			}
 
 		case save := <-endCh:
@@ -263,17 +301,17 @@ keygen:
 						}
 						pShares = append(pShares, shareStruct)
 					}
-					uj, err := pShares[:threshold+1].ReConstruct(tss.S256())
+					uj, err := pShares[:threshold+1].ReConstruct(curve)
 					assert.NoError(t, err, "vss.ReConstruct should not throw error")
 
 					// uG test: u*G[j] == V[0]
-					assert.Equal(t, uj, Pj.temp.ui)
-					uG := crypto.ScalarBaseMult(tss.EC(), uj)
+					assert.Zero(t, Pj.temp.ui.Sign(), "ui must be zeroized once keygen has finished")
+					uG := crypto.ScalarBaseMult(curve, uj)
 					assert.True(t, uG.Equals(Pj.temp.vs[0]), "ensure u*G[j] == V_0")
 
 					// xj tests: BigXj == xj*G
 					xj := Pj.data.Xi
-					gXj := crypto.ScalarBaseMult(tss.EC(), xj)
+					gXj := crypto.ScalarBaseMult(curve, xj)
 					BigXj := Pj.data.BigXj[j]
 					assert.True(t, BigXj.Equals(gXj), "ensure BigX_j == g^x_j")
 
@@ -281,10 +319,10 @@ keygen:
 					{
 						badShares := pShares[:threshold]
 						badShares[len(badShares)-1].Share.Set(big.NewInt(0))
-						uj, err := pShares[:threshold].ReConstruct(tss.S256())
+						badUj, err := pShares[:threshold].ReConstruct(curve)
 						assert.NoError(t, err)
-						assert.NotEqual(t, parties[j].temp.ui, uj)
-						BigXjX, BigXjY := tss.EC().ScalarBaseMult(uj.Bytes())
+						assert.NotEqual(t, uj, badUj)
+						BigXjX, BigXjY := curve.ScalarBaseMult(badUj.Bytes())
 						assert.NotEqual(t, BigXjX, Pj.temp.vs[0].X())
 						assert.NotEqual(t, BigXjY, Pj.temp.vs[0].Y())
 					}
@@ -294,20 +332,20 @@ keygen:
 				// build ecdsa key pair
 				pkX, pkY := save.ECDSAPub.X(), save.ECDSAPub.Y()
 				pk := ecdsa.PublicKey{
-					Curve: tss.EC(),
+					Curve: curve,
 					X:     pkX,
 					Y:     pkY,
 				}
 				sk := ecdsa.PrivateKey{
 					PublicKey: pk,
-					D:         u,
+					D:         new(big.Int).Mod(u, curve.Params().N),
 				}
 				// test pub key, should be on curve and match pkX, pkY
 				assert.True(t, sk.IsOnCurve(pkX, pkY), "public key must be on curve")
 
 				// public key tests
 				assert.NotZero(t, u, "u should not be zero")
-				ourPkX, ourPkY := tss.EC().ScalarBaseMult(u.Bytes())
+				ourPkX, ourPkY := curve.ScalarBaseMult(u.Bytes())
 				assert.Equal(t, pkX, ourPkX, "pkX should match expected pk derived from u")
 				assert.Equal(t, pkY, ourPkY, "pkY should match expected pk derived from u")
 				t.Log("Public key tests done.")
@@ -338,8 +376,55 @@ keygen:
 	}
 }
 
+// TestE2EWithShareIDs deals a key at the share IDs 1..n instead of the parties' keys
+func TestE2EWithShareIDs(t *testing.T) {
+	fixtures, _, err := LoadKeygenTestFixtures(testParticipants)
+	if err != nil {
+		t.Skip("the test fixtures were not found; run the keygen tests first")
+	}
+	pIDs := tss.GenerateTestPartyIDs(testParticipants)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	shareIDs := tss.SequentialShareIDs(len(pIDs))
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	hub := transport.NewHub()
+	defer hub.Close()
+
+	errCh := make(chan *tss.Error, len(pIDs))
+	saveCh := make(chan *LocalPartySaveData, len(pIDs))
+	for i, pID := range pIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(pIDs), testThreshold)
+		params.SetShareIDs(shareIDs)
+		// do not use in untrusted setting
+		params.SetNoProofMod()
+		// do not use in untrusted setting
+		params.SetNoProofFac()
+		conn, err := hub.Connect(pID)
+		assert.NoError(t, err)
+		outCh := make(chan tss.Message, len(pIDs))
+		endCh := make(chan *LocalPartySaveData, 1)
+		P := NewLocalParty(params, outCh, endCh, fixtures[i].LocalPreParams)
+		go func() {
+			save, err := tss.Run(ctx, P, conn, outCh, endCh)
+			errCh <- err
+			saveCh <- save
+		}()
+	}
+	for range pIDs {
+		if err := <-errCh; err != nil {
+			t.Fatal(err)
+		}
+		save := <-saveCh
+		assert.Equal(t, shareIDs, save.Ks)
+		index, err := save.OriginalIndex()
+		if assert.NoError(t, err) {
+			assert.True(t, save.BigXj[index].Equals(crypto.ScalarBaseMult(tss.S256(), save.Xi)), "ensure BigX_j == g^x_j")
+		}
+	}
+}
+
 func tryWriteTestFixtureFile(t *testing.T, index int, data LocalPartySaveData) {
-	fixtureFileName := makeTestFixtureFilePath(index)
+	fixtureFileName := makeTestFixtureFilePath(data.ECDSAPub.Curve(), index)
 
 	// fixture file does not already exist?
 	// if it does, we won't re-create it here
@@ -363,3 +448,279 @@ func tryWriteTestFixtureFile(t *testing.T, index int, data LocalPartySaveData) {
 	}
 	//
 }
+
+// tamperingTransport replaces the outbound messages for which `tamper` returns other content
+type tamperingTransport struct {
+	tss.Transport
+	tamper func(msg tss.ParsedMessage) tss.MessageContent
+}
+
+func (tt *tamperingTransport) Send(msg tss.Message) error {
+	if parsed, ok := msg.(tss.ParsedMessage); ok {
+		if content := tt.tamper(parsed); content != nil {
+			meta := tss.MessageRouting{From: msg.GetFrom(), To: msg.GetTo(), IsBroadcast: msg.IsBroadcast()}
+			msg = tss.NewMessage(meta, content, tss.NewMessageWrapper(meta, content))
+		}
+	}
+	return tt.Transport.Send(msg)
+}
+
+// corruptingTransport replaces the content of the messages that the party receives for which `corrupt` returns other
+// content, as a link that corrupts them could
+type corruptingTransport struct {
+	tss.Transport
+	corrupt func(msg tss.ParsedMessage) tss.MessageContent
+}
+
+func (ct *corruptingTransport) Receive(ctx context.Context) ([]byte, *tss.MessageRouting, error) {
+	wireBytes, routing, err := ct.Transport.Receive(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	msg, err := tss.ParseWireMessage(wireBytes, routing.From, routing.IsBroadcast)
+	if err != nil {
+		return wireBytes, routing, nil
+	}
+	if content := ct.corrupt(msg); content != nil {
+		meta := tss.MessageRouting{From: routing.From, To: msg.GetTo(), IsBroadcast: routing.IsBroadcast}
+		wireBytes, _, err = tss.NewMessage(meta, content, tss.NewMessageWrapper(meta, content)).WireBytes()
+	}
+	return wireBytes, routing, err
+}
+
+// selectiveTransport sends the broadcasts for which `strip` returns other content with that content to the parties
+// that are not in `reached`, as a sender whose broadcasts are not reliable could
+type selectiveTransport struct {
+	tss.Transport
+	parties tss.SortedPartyIDs
+	reached map[int]bool
+	strip   func(msg tss.ParsedMessage) tss.MessageContent
+}
+
+func (st *selectiveTransport) Send(msg tss.Message) error {
+	parsed, ok := msg.(tss.ParsedMessage)
+	if !ok || !msg.IsBroadcast() {
+		return st.Transport.Send(msg)
+	}
+	content := st.strip(parsed)
+	if content == nil {
+		return st.Transport.Send(msg)
+	}
+	var reached, others []*tss.PartyID
+	for _, pID := range st.parties {
+		switch {
+		case pID.Index == msg.GetFrom().Index:
+		case st.reached[pID.Index]:
+			reached = append(reached, pID)
+		default:
+			others = append(others, pID)
+		}
+	}
+	meta := tss.MessageRouting{From: msg.GetFrom(), To: reached, IsBroadcast: true}
+	if err := st.Transport.Send(tss.NewMessage(meta, parsed.Content(), tss.NewMessageWrapper(meta, parsed.Content()))); err != nil {
+		return err
+	}
+	meta = tss.MessageRouting{From: msg.GetFrom(), To: others, IsBroadcast: true}
+	return st.Transport.Send(tss.NewMessage(meta, content, tss.NewMessageWrapper(meta, content)))
+}
+
+// runComplaintKeygen runs a keygen with complaints enabled in which `tamper` may replace the messages that party 0
+// sends; it returns the save data or the error of each party, and the parties
+func runComplaintKeygen(t *testing.T, tamper func(msg tss.ParsedMessage) tss.MessageContent) ([]*LocalPartySaveData, []*tss.Error, []*LocalParty) {
+	return runComplaintKeygenWith(t, func(i int, pIDs tss.SortedPartyIDs, conn tss.Transport) tss.Transport {
+		if i == 0 {
+			return &tamperingTransport{Transport: conn, tamper: tamper}
+		}
+		return conn
+	})
+}
+
+// runComplaintKeygenWith runs a keygen with complaints enabled over the transports that `wrap` returns for the
+// connections of the parties; it returns the save data or the error of each party, and the parties
+func runComplaintKeygenWith(t *testing.T, wrap func(i int, pIDs tss.SortedPartyIDs, conn tss.Transport) tss.Transport) ([]*LocalPartySaveData, []*tss.Error, []*LocalParty) {
+	fixtures, _, err := LoadKeygenTestFixtures(testParticipants)
+	if err != nil {
+		t.Skip("the test fixtures were not found; run the keygen tests first")
+	}
+	pIDs := tss.GenerateTestPartyIDs(testParticipants)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	hub := transport.NewHub()
+	defer hub.Close()
+
+	type result struct {
+		index int
+		save  *LocalPartySaveData
+		err   *tss.Error
+	}
+	resCh := make(chan result, len(pIDs))
+	parties := make([]*LocalParty, 0, len(pIDs))
+	equivocators := 0
+	for i, pID := range pIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(pIDs), testThreshold)
+		params.SetComplaints()
+		params.SetEchoBroadcast()
+		// do not use in untrusted setting
+		params.SetNoProofMod()
+		// do not use in untrusted setting
+		params.SetNoProofFac()
+		conn, err := hub.Connect(pID)
+		assert.NoError(t, err)
+		outCh := make(chan tss.Message, len(pIDs))
+		endCh := make(chan *LocalPartySaveData, 1)
+		P := NewLocalParty(params, outCh, endCh, fixtures[i].LocalPreParams).(*LocalParty)
+		parties = append(parties, P)
+		transport := wrap(i, pIDs, conn)
+		if _, ok := transport.(*selectiveTransport); ok {
+			equivocators++
+		}
+		go func(i int) {
+			save, err := tss.Run(ctx, P, transport, outCh, endCh)
+			resCh <- result{i, save, err}
+		}(i)
+	}
+	// a party that equivocates may wait for the parties that aborted, so it is stopped once the others have ended
+	saves, errs := make([]*LocalPartySaveData, len(pIDs)), make([]*tss.Error, len(pIDs))
+	for ended := range pIDs {
+		if ended == len(pIDs)-equivocators {
+			cancel()
+		}
+		res := <-resCh
+		saves[res.index], errs[res.index] = res.save, res.err
+	}
+	return saves, errs, parties
+}
+
+func TestComplaints(t *testing.T) {
+	// party 0 deals party 1 a bad share, but reveals the right one: the complaint is resolved and keygen completes, and
+	// as this cannot be told apart from a false complaint, party 1 is reported as a false accuser
+	saves, errs, parties := runComplaintKeygen(t, func(msg tss.ParsedMessage) tss.MessageContent {
+		if r2msg1, ok := msg.Content().(*KGRound2Message1); ok && msg.GetTo()[0].Index == 1 {
+			tampered := proto.Clone(r2msg1).(*KGRound2Message1)
+			tampered.Share = new(big.Int).Add(r2msg1.UnmarshalShare(), big.NewInt(1)).Bytes()
+			return tampered
+		}
+		return nil
+	})
+	for i, save := range saves {
+		if !assert.Nil(t, errs[i]) {
+			return
+		}
+		assert.True(t, save.ECDSAPub.Equals(saves[0].ECDSAPub))
+		assert.True(t, save.BigXj[i].Equals(crypto.ScalarBaseMult(tss.S256(), save.Xi)), "ensure BigX_j == g^x_j")
+		assertFalseAccuser(t, parties[i], 1)
+	}
+
+	// party 0 also reveals a bad share: every other party blames party 0
+	_, errs, _ = runComplaintKeygen(t, func(msg tss.ParsedMessage) tss.MessageContent {
+		switch content := msg.Content().(type) {
+		case *KGRound2Message1:
+			if msg.GetTo()[0].Index == 1 {
+				tampered := proto.Clone(content).(*KGRound2Message1)
+				tampered.Share = new(big.Int).Add(content.UnmarshalShare(), big.NewInt(1)).Bytes()
+				return tampered
+			}
+		case *KGRound4Message:
+			tampered := proto.Clone(content).(*KGRound4Message)
+			tampered.Shares[0] = new(big.Int).Add(new(big.Int).SetBytes(content.Shares[0]), big.NewInt(1)).Bytes()
+			return tampered
+		}
+		return nil
+	})
+	for i, err := range errs[1:] {
+		if assert.NotNil(t, err, "party %d", i+1) {
+			assert.ErrorIs(t, err, tss.ErrVSSFailure)
+			assert.Equal(t, 5, err.Round())
+			if assert.Len(t, err.Culprits(), 1) {
+				assert.Equal(t, 0, err.Culprits()[0].Index)
+			}
+		}
+	}
+}
+
+// TestFalseComplaint has party 1 complain about the valid share that party 0 dealt it, which a link corrupted on the way:
+// party 0 reveals the share, keygen completes and every party reports party 1 as a false accuser
+func TestFalseComplaint(t *testing.T) {
+	saves, errs, parties := runComplaintKeygenWith(t, func(i int, pIDs tss.SortedPartyIDs, conn tss.Transport) tss.Transport {
+		if i != 1 {
+			return conn
+		}
+		return &corruptingTransport{Transport: conn, corrupt: func(msg tss.ParsedMessage) tss.MessageContent {
+			if r2msg1, ok := msg.Content().(*KGRound2Message1); ok && msg.GetFrom().Index == 0 {
+				corrupted := proto.Clone(r2msg1).(*KGRound2Message1)
+				corrupted.Share = new(big.Int).Add(r2msg1.UnmarshalShare(), big.NewInt(1)).Bytes()
+				return corrupted
+			}
+			return nil
+		}}
+	})
+	for i, save := range saves {
+		if !assert.Nil(t, errs[i], "party %d", i) {
+			return
+		}
+		assert.True(t, save.ECDSAPub.Equals(saves[0].ECDSAPub))
+		assert.True(t, save.BigXj[i].Equals(crypto.ScalarBaseMult(tss.S256(), save.Xi)), "ensure BigX_j == g^x_j")
+		assertFalseAccuser(t, parties[i], 1)
+	}
+}
+
+// assertFalseAccuser asserts that the party reports the accuser, and only it, as a false accuser, with its complaint
+// as evidence
+func assertFalseAccuser(t *testing.T, party *LocalParty, accuser int) {
+	err := party.FalseComplaints()
+	if !assert.NotNil(t, err, "party %d", party.PartyID().Index) {
+		return
+	}
+	assert.ErrorIs(t, err, tss.ErrFalseComplaint)
+	assert.Equal(t, tss.CodeFalseComplaint, err.Code())
+	assert.Equal(t, 5, err.Round())
+	if assert.Len(t, err.Culprits(), 1) {
+		assert.Equal(t, accuser, err.Culprits()[0].Index)
+	}
+	if assert.NotEmpty(t, err.Evidence()) {
+		msg, pErr := err.Evidence()[0].Message()
+		if assert.NoError(t, pErr) {
+			assert.Equal(t, accuser, msg.GetFrom().Index)
+			assert.IsType(t, &KGRound3Message{}, msg.Content())
+		}
+	}
+}
+
+// TestComplaintDeliveredSelectively has party 1 complain about its share from party 0 to parties 2 and 3 only; the
+// echo broadcast, which complaints require, makes every other party abort instead of reaching different verdicts
+func TestComplaintDeliveredSelectively(t *testing.T) {
+	_, errs, _ := runComplaintKeygenWith(t, func(i int, pIDs tss.SortedPartyIDs, conn tss.Transport) tss.Transport {
+		switch i {
+		case 0:
+			return &tamperingTransport{Transport: conn, tamper: func(msg tss.ParsedMessage) tss.MessageContent {
+				if r2msg1, ok := msg.Content().(*KGRound2Message1); ok && msg.GetTo()[0].Index == 1 {
+					tampered := proto.Clone(r2msg1).(*KGRound2Message1)
+					tampered.Share = new(big.Int).Add(r2msg1.UnmarshalShare(), big.NewInt(1)).Bytes()
+					return tampered
+				}
+				return nil
+			}}
+		case 1:
+			return &selectiveTransport{Transport: conn, parties: pIDs, reached: map[int]bool{2: true, 3: true}, strip: func(msg tss.ParsedMessage) tss.MessageContent {
+				if r3msg, ok := msg.Content().(*KGRound3Message); ok && 0 < len(r3msg.GetComplaints()) {
+					stripped := proto.Clone(r3msg).(*KGRound3Message)
+					stripped.Complaints = nil
+					return stripped
+				}
+				return nil
+			}}
+		}
+		return conn
+	})
+	for i, err := range errs {
+		if i == 1 {
+			continue
+		}
+		if assert.NotNil(t, err, "party %d", i) {
+			assert.ErrorIs(t, err, tss.ErrEquivocation)
+			// the broadcasts are not signed, so party 1 is named along with the echoer that disagreed
+			assert.True(t, slices.ContainsFunc(err.Culprits(), func(pID *tss.PartyID) bool { return pID.Index == 1 }), "party %d", i)
+		}
+	}
+}
@@ -29,6 +29,7 @@ var (
 		(*KGRound2Message1)(nil),
 		(*KGRound2Message2)(nil),
 		(*KGRound3Message)(nil),
+		(*KGRound4Message)(nil),
 	}
 )
 
@@ -68,14 +69,18 @@ func NewKGRound1Message(
 
 func (m *KGRound1Message) ValidateBasic() bool {
 	return m != nil &&
-		common.NonEmptyBytes(m.GetCommitment()) &&
-		common.NonEmptyBytes(m.GetPaillierN()) &&
-		common.NonEmptyBytes(m.GetNTilde()) &&
-		common.NonEmptyBytes(m.GetH1()) &&
-		common.NonEmptyBytes(m.GetH2()) &&
+		common.BoundedBytes(m.GetCommitment(), tss.MaxHashBytes) &&
+		common.BoundedBytes(m.GetPaillierN(), tss.MaxModulusBytes) &&
+		common.BoundedBytes(m.GetNTilde(), tss.MaxModulusBytes) &&
+		common.BoundedBytes(m.GetH1(), tss.MaxModulusBytes) &&
+		common.BoundedBytes(m.GetH2(), tss.MaxModulusBytes) &&
 		// expected len of dln proof = sizeof(int64) + len(alpha) + len(t)
-		common.NonEmptyMultiBytes(m.GetDlnproof_1(), 2+(dlnproof.Iterations*2)) &&
-		common.NonEmptyMultiBytes(m.GetDlnproof_2(), 2+(dlnproof.Iterations*2))
+		common.BoundedMultiBytes(m.GetDlnproof_1(), tss.MaxModulusBytes, 2+(dlnproof.Iterations*2)) &&
+		common.BoundedMultiBytes(m.GetDlnproof_2(), tss.MaxModulusBytes, 2+(dlnproof.Iterations*2))
+}
+
+func (m *KGRound1Message) RoundNumber() int {
+	return 1
 }
 
 func (m *KGRound1Message) UnmarshalCommitment() *big.Int {
@@ -129,9 +134,14 @@ func NewKGRound2Message1(
 
 func (m *KGRound2Message1) ValidateBasic() bool {
 	return m != nil &&
-		common.NonEmptyBytes(m.GetShare())
-	// This is commented for backward compatibility, which msg has no proof
-	// && common.NonEmptyMultiBytes(m.GetFacProof(), facproof.ProofFacBytesParts)
+		common.BoundedBytes(m.GetShare(), tss.MaxScalarBytes) &&
+		// the proof is zero with NoProofFac() and absent from older versions, for backward compatibility
+		(len(m.GetFacProof()) == 0 || len(m.GetFacProof()) == facproof.ProofFacBytesParts) &&
+		common.MaxLenMultiBytes(m.GetFacProof(), tss.MaxProofPartBytes)
+}
+
+func (m *KGRound2Message1) RoundNumber() int {
+	return 2
 }
 
 func (m *KGRound2Message1) UnmarshalShare() *big.Int {
@@ -165,9 +175,14 @@ func NewKGRound2Message2(
 
 func (m *KGRound2Message2) ValidateBasic() bool {
 	return m != nil &&
-		common.NonEmptyMultiBytes(m.GetDeCommitment())
-	// This is commented for backward compatibility, which msg has no proof
-	// && common.NonEmptyMultiBytes(m.GetModProof(), modproof.ProofModBytesParts)
+		common.BoundedMultiBytes(m.GetDeCommitment(), tss.MaxScalarBytes) &&
+		// the proof is zero with NoProofMod() and absent from older versions, for backward compatibility
+		(len(m.GetModProof()) == 0 || len(m.GetModProof()) == modproof.ProofModBytesParts) &&
+		common.MaxLenMultiBytes(m.GetModProof(), tss.MaxModulusBytes)
+}
+
+func (m *KGRound2Message2) RoundNumber() int {
+	return 2
 }
 
 func (m *KGRound2Message2) UnmarshalDeCommitment() []*big.Int {
@@ -184,6 +199,7 @@ func (m *KGRound2Message2) UnmarshalModProof() (*modproof.ProofMod, error) {
 func NewKGRound3Message(
 	from *tss.PartyID,
 	proof paillier.Proof,
+	complaints []int,
 ) tss.ParsedMessage {
 	meta := tss.MessageRouting{
 		From:        from,
@@ -198,6 +214,7 @@ func NewKGRound3Message(
 	}
 	content := &KGRound3Message{
 		PaillierProof: pfBzs,
+		Complaints:    indicesToUint32s(complaints),
 	}
 	msg := tss.NewMessageWrapper(meta, content)
 	return tss.NewMessage(meta, content, msg)
@@ -205,7 +222,15 @@ func NewKGRound3Message(
 
 func (m *KGRound3Message) ValidateBasic() bool {
 	return m != nil &&
-		common.NonEmptyMultiBytes(m.GetPaillierProof(), paillier.ProofIters)
+		common.BoundedMultiBytes(m.GetPaillierProof(), tss.MaxModulusBytes, paillier.ProofIters)
+}
+
+func (m *KGRound3Message) RoundNumber() int {
+	return 3
+}
+
+func (m *KGRound3Message) UnmarshalComplaints() []int {
+	return uint32sToIndices(m.GetComplaints())
 }
 
 func (m *KGRound3Message) UnmarshalProofInts() paillier.Proof {
@@ -216,3 +241,64 @@ func (m *KGRound3Message) UnmarshalProofInts() paillier.Proof {
 	}
 	return pf
 }
+
+// ----- //
+
+func NewKGRound4Message(
+	from *tss.PartyID,
+	accusers []int,
+	shares []*big.Int,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &KGRound4Message{
+		Accusers: indicesToUint32s(accusers),
+		Shares:   common.BigIntsToBytes(shares),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *KGRound4Message) ValidateBasic() bool {
+	return m != nil &&
+		0 < len(m.GetAccusers()) &&
+		common.BoundedMultiBytes(m.GetShares(), tss.MaxScalarBytes, len(m.GetAccusers()))
+}
+
+func (m *KGRound4Message) RoundNumber() int {
+	return 4
+}
+
+// UnmarshalShares returns the revealed shares by the index of their accuser
+func (m *KGRound4Message) UnmarshalShares() map[int]*big.Int {
+	shares := make(map[int]*big.Int, len(m.GetAccusers()))
+	for k, accuser := range m.UnmarshalAccusers() {
+		shares[accuser] = new(big.Int).SetBytes(m.GetShares()[k])
+	}
+	return shares
+}
+
+func (m *KGRound4Message) UnmarshalAccusers() []int {
+	return uint32sToIndices(m.GetAccusers())
+}
+
+func indicesToUint32s(indices []int) []uint32 {
+	if len(indices) == 0 {
+		return nil
+	}
+	out := make([]uint32, len(indices))
+	for k, idx := range indices {
+		out[k] = uint32(idx)
+	}
+	return out
+}
+
+func uint32sToIndices(values []uint32) []int {
+	out := make([]int, len(values))
+	for k, v := range values {
+		out[k] = int(v)
+	}
+	return out
+}
@@ -0,0 +1,338 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/SafeMPC/tss-lib/common"
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+const (
+	// the delay before the pool retries after it failed to generate or store pre-parameters, or checks a full store again
+	preParamsRetryDelay = 10 * time.Second
+	preParamsFileExt    = ".json"
+)
+
+type (
+	// PreParamsStore persists the pre-parameters of a PreParamsPool. Take must hand out every pre-parameters at most
+	// once, even to pools in other processes that share the store.
+	PreParamsStore interface {
+		// Put stores pre-parameters
+		Put(preParams *LocalPreParams) error
+		// Take removes and returns stored pre-parameters, or nil if there are none
+		Take() (*LocalPreParams, error)
+		// Len is the number of stored pre-parameters
+		Len() (int, error)
+	}
+
+	// PreParamsPool generates pre-parameters in the background until its store holds the target count, so that keygen
+	// and re-sharing do not wait for the safe primes and the Paillier modulus to be generated. Start the pool, draw from
+	// it with Take or NewLocalPartyFromPool, and Close it to stop the generation.
+	//
+	// Pre-parameters are handed out once and never reused; pre-parameters that do not pass ValidateWithProof are
+	// discarded.
+	PreParamsPool struct {
+		store       PreParamsStore
+		target      int
+		modulusBits int
+		concurrency int
+		workerPool  *tss.WorkerPool
+
+		mtx     sync.Mutex
+		added   chan struct{} // closed and replaced whenever pre-parameters are added to the store
+		wake    chan struct{}
+		started bool
+		cancel  context.CancelFunc
+		done    chan struct{}
+	}
+
+	memoryPreParamsStore struct {
+		mtx       sync.Mutex
+		preParams []*LocalPreParams
+	}
+
+	filePreParamsStore struct {
+		dir string
+	}
+)
+
+// NewPreParamsPool creates a pool that keeps `target` pre-parameters in `store`
+func NewPreParamsPool(store PreParamsStore, target int) *PreParamsPool {
+	return &PreParamsPool{
+		store:       store,
+		target:      target,
+		modulusBits: tss.DefaultModulusBits,
+		concurrency: runtime.GOMAXPROCS(0),
+		added:       make(chan struct{}),
+		wake:        make(chan struct{}, 1),
+		done:        make(chan struct{}),
+	}
+}
+
+// SetModulusBits sets the bit length of the moduli of the pre-parameters, which must match the tss.Parameters.ModulusBits
+// of the parties that draw from the pool; it defaults to tss.DefaultModulusBits. Call it before Start.
+func (pool *PreParamsPool) SetModulusBits(bits int) {
+	pool.modulusBits = bits
+}
+
+// ModulusBits is the bit length of the moduli of the pre-parameters
+func (pool *PreParamsPool) ModulusBits() int {
+	return pool.modulusBits
+}
+
+// SetConcurrency sets the concurrency with which pre-parameters are generated; it defaults to runtime.GOMAXPROCS(0)
+func (pool *PreParamsPool) SetConcurrency(concurrency int) {
+	pool.concurrency = concurrency
+}
+
+// SetWorkerPool generates the pre-parameters on the shared workers at tss.PriorityLow, so that the generation does
+// not hold up the sessions that run on them
+func (pool *PreParamsPool) SetWorkerPool(workerPool *tss.WorkerPool) {
+	pool.workerPool = workerPool
+}
+
+// Start starts generating pre-parameters in the background
+func (pool *PreParamsPool) Start() {
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+	if pool.started {
+		return
+	}
+	pool.started = true
+	ctx, cancel := context.WithCancel(context.Background())
+	pool.cancel = cancel
+	go pool.fill(ctx)
+}
+
+// Close stops the generation of pre-parameters and waits for it. Pre-parameters that are stored remain in the store.
+func (pool *PreParamsPool) Close() {
+	pool.mtx.Lock()
+	started := pool.started
+	if started {
+		pool.cancel()
+	}
+	pool.mtx.Unlock()
+	if started {
+		<-pool.done
+	}
+}
+
+// Len is the number of pre-parameters in the store
+func (pool *PreParamsPool) Len() (int, error) {
+	return pool.store.Len()
+}
+
+// Take removes pre-parameters from the store and returns them, waiting for new ones to be generated if the store is
+// empty until ctx is done. Pre-parameters that fail ValidateWithProof or do not have moduli of ModulusBits bits are
+// discarded.
+func (pool *PreParamsPool) Take(ctx context.Context) (*LocalPreParams, error) {
+	for {
+		pool.mtx.Lock()
+		added := pool.added
+		pool.mtx.Unlock()
+
+		preParams, err := pool.store.Take()
+		if err != nil {
+			return nil, err
+		}
+		if preParams != nil {
+			pool.refill()
+			if preParams.ValidateWithProof() && preParams.HasModulusBits(pool.modulusBits) {
+				return preParams, nil
+			}
+			common.Logger.Warn("discarded pre-parameters that failed to validate or have moduli of another size")
+			continue
+		}
+		select {
+		case <-added:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// refill wakes the generation once pre-parameters were taken
+func (pool *PreParamsPool) refill() {
+	select {
+	case pool.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (pool *PreParamsPool) fill(ctx context.Context) {
+	defer close(pool.done)
+	exec := common.GoExecutor
+	if pool.workerPool != nil {
+		exec = pool.workerPool.Executor(pool, tss.PriorityLow)
+	}
+	ctx = common.WithExecutor(ctx, exec)
+	for {
+		full, err := pool.generate(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			common.Logger.Errorf("pre-parameters pool: %v", err)
+		} else if !full {
+			continue
+		}
+		// wait until pre-parameters are taken; the store is checked again after a while, as it may be shared
+		timer := time.NewTimer(preParamsRetryDelay)
+		select {
+		case <-pool.wake:
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		}
+		timer.Stop()
+	}
+}
+
+// generate adds pre-parameters to the store unless it holds the target count, and reports whether it does
+func (pool *PreParamsPool) generate(ctx context.Context) (bool, error) {
+	n, err := pool.store.Len()
+	if err != nil {
+		return false, err
+	}
+	if pool.target <= n {
+		return true, nil
+	}
+	preParams, err := GeneratePreParamsWithModulusBits(ctx, rand.Reader, pool.modulusBits, pool.concurrency)
+	if err != nil {
+		return false, err
+	}
+	if err = pool.store.Put(preParams); err != nil {
+		return false, err
+	}
+	pool.mtx.Lock()
+	close(pool.added)
+	pool.added = make(chan struct{})
+	pool.mtx.Unlock()
+	return false, nil
+}
+
+// NewMemoryPreParamsStore creates a PreParamsStore that keeps the pre-parameters in memory
+func NewMemoryPreParamsStore(preParams ...*LocalPreParams) PreParamsStore {
+	return &memoryPreParamsStore{preParams: preParams}
+}
+
+func (store *memoryPreParamsStore) Put(preParams *LocalPreParams) error {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+	store.preParams = append(store.preParams, preParams)
+	return nil
+}
+
+func (store *memoryPreParamsStore) Take() (*LocalPreParams, error) {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+	if len(store.preParams) == 0 {
+		return nil, nil
+	}
+	preParams := store.preParams[0]
+	store.preParams[0] = nil
+	store.preParams = store.preParams[1:]
+	return preParams, nil
+}
+
+func (store *memoryPreParamsStore) Len() (int, error) {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+	return len(store.preParams), nil
+}
+
+// NewFilePreParamsStore creates a PreParamsStore that keeps each pre-parameters in a JSON file of its own in dir.
+// A file is claimed by renaming it before it is read and removed, so that processes that share the directory never
+// take the same pre-parameters. The files hold the Paillier secret key; keep the directory private.
+func NewFilePreParamsStore(dir string) (PreParamsStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &filePreParamsStore{dir: dir}, nil
+}
+
+func (store *filePreParamsStore) Put(preParams *LocalPreParams) error {
+	bz, err := json.Marshal(preParams)
+	if err != nil {
+		return err
+	}
+	name := make([]byte, 16)
+	if _, err = rand.Read(name); err != nil {
+		return err
+	}
+	// written under a temporary name first, so that a partly written file is never taken
+	path := filepath.Join(store.dir, hex.EncodeToString(name))
+	if err = os.WriteFile(path+".tmp", bz, 0600); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path+preParamsFileExt)
+}
+
+func (store *filePreParamsStore) Take() (*LocalPreParams, error) {
+	names, err := store.names()
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		path := filepath.Join(store.dir, name)
+		claimed := path + ".taken"
+		if err := os.Rename(path, claimed); err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue // taken by another process
+			}
+			return nil, err
+		}
+		bz, err := os.ReadFile(claimed)
+		if err != nil {
+			return nil, err
+		}
+		if err = os.Remove(claimed); err != nil {
+			return nil, err
+		}
+		preParams := new(LocalPreParams)
+		if err = json.Unmarshal(bz, preParams); err != nil {
+			return nil, fmt.Errorf("could not read the pre-parameters in %s: %w", name, err)
+		}
+		return preParams, nil
+	}
+	return nil, nil
+}
+
+func (store *filePreParamsStore) Len() (int, error) {
+	names, err := store.names()
+	return len(names), err
+}
+
+func (store *filePreParamsStore) names() ([]string, error) {
+	entries, err := os.ReadDir(store.dir)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), preParamsFileExt) {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
@@ -0,0 +1,100 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+func fixturePreParams(t *testing.T) []*LocalPreParams {
+	fixtures, _, err := LoadKeygenTestFixtures(testParticipants)
+	if err != nil {
+		t.Skip("the test fixtures were not found; run the keygen tests first")
+	}
+	preParams := make([]*LocalPreParams, len(fixtures))
+	for i := range fixtures {
+		preParams[i] = &fixtures[i].LocalPreParams
+	}
+	return preParams
+}
+
+func TestPreParamsStores(t *testing.T) {
+	preParams := fixturePreParams(t)
+	fileStore, err := NewFilePreParamsStore(t.TempDir())
+	if !assert.NoError(t, err) {
+		return
+	}
+	for name, store := range map[string]PreParamsStore{"memory": NewMemoryPreParamsStore(), "file": fileStore} {
+		for _, pp := range preParams {
+			assert.NoError(t, store.Put(pp), name)
+		}
+		n, err := store.Len()
+		assert.NoError(t, err, name)
+		assert.Equal(t, len(preParams), n, name)
+
+		// every pre-parameters are taken exactly once, also when they are taken concurrently
+		var mtx sync.Mutex
+		var wg sync.WaitGroup
+		taken := make(map[string]bool)
+		for range preParams {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				pp, err := store.Take()
+				if assert.NoError(t, err, name) && assert.NotNil(t, pp, name) {
+					assert.True(t, pp.ValidateWithProof(), name)
+					mtx.Lock()
+					taken[pp.NTildei.String()] = true
+					mtx.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+		assert.Len(t, taken, len(preParams), name)
+		pp, err := store.Take()
+		assert.NoError(t, err, name)
+		assert.Nil(t, pp, name)
+	}
+}
+
+func TestPreParamsPool(t *testing.T) {
+	preParams := fixturePreParams(t)
+	invalid := &LocalPreParams{PaillierSK: preParams[0].PaillierSK, NTildei: preParams[0].NTildei}
+	pool := NewPreParamsPool(NewMemoryPreParamsStore(invalid, preParams[0], preParams[1]), 2)
+	pool.SetConcurrency(1)
+	pool.Start()
+
+	// invalid pre-parameters are discarded
+	pp, err := pool.Take(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, preParams[0], pp)
+
+	pIDs := tss.GenerateTestPartyIDs(testParticipants)
+	params := tss.NewParameters(tss.S256(), tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), testThreshold)
+	P, err := NewLocalPartyFromPool(context.Background(), pool, params, nil, nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, *preParams[1], P.(*LocalParty).data.LocalPreParams)
+	}
+
+	// the pool is empty until it has generated new pre-parameters
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = pool.Take(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// closing the pool stops the generation
+	start := time.Now()
+	pool.Close()
+	assert.WithinDuration(t, start, time.Now(), 5*time.Second)
+}
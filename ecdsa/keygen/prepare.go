@@ -10,6 +10,7 @@ import (
 	"context"
 	"crypto/rand"
 	"errors"
+	"fmt"
 	"io"
 	"math/big"
 	"runtime"
@@ -17,13 +18,10 @@ import (
 
 	"github.com/SafeMPC/tss-lib/common"
 	"github.com/SafeMPC/tss-lib/crypto/paillier"
+	"github.com/SafeMPC/tss-lib/tss"
 )
 
 const (
-	// Using a modulus length of 2048 is recommended in the GG18 spec
-	paillierModulusLen = 2048
-	// Two 1024-bit safe primes to produce NTilde
-	safePrimeBitLen = 1024
 	// Ticker for printing log statements while generating primes/modulus
 	logProgressTickInterval = 8 * time.Second
 	// Safe big len using random for ssid
@@ -53,6 +51,17 @@ func GeneratePreParamsWithContext(ctx context.Context, optionalConcurrency ...in
 // If not specified, a concurrency value equal to the number of available CPU cores will be used.
 // If pre-parameters could not be generated before the context is done, an error is returned.
 func GeneratePreParamsWithContextAndRandom(ctx context.Context, rand io.Reader, optionalConcurrency ...int) (*LocalPreParams, error) {
+	return GeneratePreParamsWithModulusBits(ctx, rand, tss.DefaultModulusBits, optionalConcurrency...)
+}
+
+// GeneratePreParamsWithModulusBits generates pre-parameters with a Paillier modulus and NTilde of `modulusBits` bits,
+// which must match the tss.Parameters.ModulusBits of the keygen or re-sharing that they are used in.
+// Using a modulus length of 2048 is recommended in the GG18 spec; NTilde is the product of two safe primes of half
+// that length.
+func GeneratePreParamsWithModulusBits(ctx context.Context, rand io.Reader, modulusBits int, optionalConcurrency ...int) (*LocalPreParams, error) {
+	if modulusBits < tss.MinModulusBits || tss.MaxModulusBits < modulusBits || modulusBits%2 != 0 {
+		return nil, fmt.Errorf("cannot generate pre-params with a modulus of %d bits", modulusBits)
+	}
 	var concurrency int
 	if 0 < len(optionalConcurrency) {
 		if 1 < len(optionalConcurrency) {
@@ -75,7 +84,7 @@ func GeneratePreParamsWithContextAndRandom(ctx context.Context, rand io.Reader,
 		common.Logger.Info("generating the Paillier modulus, please wait...")
 		start := time.Now()
 		// more concurrency weight is assigned here because the paillier primes have a requirement of having "large" P-Q
-		PiPaillierSk, _, err := paillier.GenerateKeyPair(ctx, rand, paillierModulusLen, concurrency*2)
+		PiPaillierSk, _, err := paillier.GenerateKeyPair(ctx, rand, modulusBits, concurrency*2)
 		if err != nil {
 			ch <- nil
 			return
@@ -89,7 +98,7 @@ func GeneratePreParamsWithContextAndRandom(ctx context.Context, rand io.Reader,
 		var err error
 		common.Logger.Info("generating the safe primes for the signing proofs, please wait...")
 		start := time.Now()
-		sgps, err := common.GetRandomSafePrimesConcurrent(ctx, safePrimeBitLen, 2, concurrency, rand)
+		sgps, err := common.GetRandomSafePrimesConcurrent(ctx, modulusBits/2, 2, concurrency, rand)
 		if err != nil {
 			ch <- nil
 			return
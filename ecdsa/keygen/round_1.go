@@ -9,6 +9,7 @@ package keygen
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math/big"
 
 	"github.com/SafeMPC/tss-lib/common"
@@ -45,7 +46,8 @@ func (round *round1) Start() *tss.Error {
 	round.temp.ui = ui
 
 	// 2. compute the vss shares
-	ids := round.Parties().IDs().Keys()
+	// the shares are evaluated at the share IDs of the parties, which are their keys unless set explicitly
+	ids := append([]*big.Int(nil), round.Params().ShareIDs()...)
 	vs, shares, err := vss.Create(round.EC(), round.Threshold(), ui, ids, round.Rand())
 	if err != nil {
 		return round.WrapError(err, Pi)
@@ -70,23 +72,35 @@ func (round *round1) Start() *tss.Error {
 	// 5-7. generate safe primes for ZKPs used later on
 	// 9-11. compute ntilde, h1, h2 (uses safe primes)
 	// use the pre-params if they were provided to the LocalParty constructor
+	modulusBits := round.ModulusBits()
+	if modulusBits < tss.CurveModulusBits(round.EC()) {
+		return round.WrapError(tss.Classify(
+			fmt.Errorf("a modulus of %d bits is too small for the range proofs over this curve", modulusBits), tss.ErrInvalidParameters))
+	}
 	var preParams *LocalPreParams
 	if round.save.LocalPreParams.Validate() && !round.save.LocalPreParams.ValidateWithProof() {
 		return round.WrapError(
 			errors.New("`optionalPreParams` failed to validate; it might have been generated with an older version of tss-lib"))
 	} else if round.save.LocalPreParams.ValidateWithProof() {
+		if !round.save.LocalPreParams.HasModulusBits(modulusBits) {
+			return round.WrapError(tss.Classify(
+				fmt.Errorf("`optionalPreParams` do not have moduli of %d bits", modulusBits), tss.ErrInvalidParameters))
+		}
 		preParams = &round.save.LocalPreParams
 	} else {
 		{
 			ctx, cancel := context.WithTimeout(context.Background(), round.SafePrimeGenTimeout())
 			defer cancel()
-			preParams, err = GeneratePreParamsWithContextAndRandom(ctx, round.Rand(), round.Concurrency())
+			// pre-parameters are background work that should not hold up the signings that share the worker pool
+			ctx = common.WithExecutor(ctx, round.Executor(tss.PriorityLow))
+			preParams, err = GeneratePreParamsWithModulusBits(ctx, round.Rand(), modulusBits, round.Concurrency())
 			if err != nil {
 				return round.WrapError(errors.New("pre-params generation failed"), Pi)
 			}
 		}
 	}
 	round.save.LocalPreParams = *preParams
+	round.save.ModulusBits = modulusBits
 	round.save.NTildej[i] = preParams.NTildei
 	round.save.H1j[i], round.save.H2j[i] = preParams.H1i, preParams.H2i
 
@@ -98,15 +112,18 @@ func (round *round1) Start() *tss.Error {
 		preParams.P,
 		preParams.Q,
 		preParams.NTildei
-	dlnProof1 := dlnproof.NewDLNProof(h1i, h2i, alpha, p, q, NTildei, round.Rand())
-	dlnProof2 := dlnproof.NewDLNProof(h2i, h1i, beta, p, q, NTildei, round.Rand())
+	var dlnProof1, dlnProof2 *dlnproof.Proof
+	common.RunOn(round.Executor(), func() {
+		dlnProof1 = dlnproof.NewDLNProof(h1i, h2i, alpha, p, q, NTildei, round.Rand())
+		dlnProof2 = dlnproof.NewDLNProof(h2i, h1i, beta, p, q, NTildei, round.Rand())
+	})
 
 	// for this P: SAVE
 	// - shareID
 	// and keep in temporary storage:
 	// - VSS Vs
 	// - our set of Shamir shares
-	round.temp.ssidNonce = new(big.Int).SetUint64(0)
+	round.temp.ssidNonce = round.SSIDNonce()
 	round.save.ShareID = ids[i]
 	round.temp.vs = vs
 	ssid, err := round.getSSID()
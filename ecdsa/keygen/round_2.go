@@ -9,8 +9,10 @@ package keygen
 import (
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"math/big"
 	"sync"
+	"time"
 
 	"github.com/SafeMPC/tss-lib/crypto/facproof"
 	"github.com/SafeMPC/tss-lib/crypto/modproof"
@@ -19,10 +21,6 @@ import (
 	"github.com/SafeMPC/tss-lib/tss"
 )
 
-const (
-	paillierBitsLen = 2048
-)
-
 func (round *round2) Start() *tss.Error {
 	if round.started {
 		return round.WrapError(errors.New("round already started"))
@@ -31,16 +29,13 @@ func (round *round2) Start() *tss.Error {
 	round.started = true
 	round.resetOK()
 
-	common.Logger.Debugf(
-		"%s Setting up DLN verification with concurrency level of %d",
-		round.PartyID(),
-		round.Concurrency(),
-	)
-	dlnVerifier := NewDlnProofVerifier(round.Concurrency())
+	round.logger().Debug("setting up DLN verification", "concurrency", round.Concurrency())
+	dlnVerifier := NewDlnProofVerifier(round.Concurrency(), round.Executor())
 
 	i := round.PartyID().Index
 
 	// 6. verify dln proofs, store r1 message pieces, ensure uniqueness of h1j, h2j
+	modulusBits := round.ModulusBits()
 	h1H2Map := make(map[string]struct{}, len(round.temp.kgRound1Messages)*2)
 	dlnProof1FailCulprits := make([]*tss.PartyID, len(round.temp.kgRound1Messages))
 	dlnProof2FailCulprits := make([]*tss.PartyID, len(round.temp.kgRound1Messages))
@@ -51,21 +46,21 @@ func (round *round2) Start() *tss.Error {
 			r1msg.UnmarshalH2(),
 			r1msg.UnmarshalNTilde(),
 			r1msg.UnmarshalPaillierPK()
-		if paillierPKj.N.BitLen() != paillierBitsLen {
-			return round.WrapError(errors.New("got paillier modulus with insufficient bits for this party"), msg.GetFrom())
+		if paillierPKj.N.BitLen() != modulusBits {
+			return round.WrapError(tss.Classify(fmt.Errorf("got a paillier modulus of %d bits instead of %d from this party", paillierPKj.N.BitLen(), modulusBits), tss.ErrBadMessage), msg.GetFrom()).WithMessages(msg)
 		}
 		if H1j.Cmp(H2j) == 0 {
-			return round.WrapError(errors.New("h1j and h2j were equal for this party"), msg.GetFrom())
+			return round.WrapError(tss.Classify(errors.New("h1j and h2j were equal for this party"), tss.ErrBadMessage), msg.GetFrom()).WithMessages(msg)
 		}
-		if NTildej.BitLen() != paillierBitsLen {
-			return round.WrapError(errors.New("got NTildej with insufficient bits for this party"), msg.GetFrom())
+		if NTildej.BitLen() != modulusBits {
+			return round.WrapError(tss.Classify(fmt.Errorf("got an NTildej of %d bits instead of %d from this party", NTildej.BitLen(), modulusBits), tss.ErrBadMessage), msg.GetFrom()).WithMessages(msg)
 		}
 		h1JHex, h2JHex := hex.EncodeToString(H1j.Bytes()), hex.EncodeToString(H2j.Bytes())
 		if _, found := h1H2Map[h1JHex]; found {
-			return round.WrapError(errors.New("this h1j was already used by another party"), msg.GetFrom())
+			return round.WrapError(tss.Classify(errors.New("this h1j was already used by another party"), tss.ErrBadMessage), msg.GetFrom()).WithMessages(msg)
 		}
 		if _, found := h1H2Map[h2JHex]; found {
-			return round.WrapError(errors.New("this h2j was already used by another party"), msg.GetFrom())
+			return round.WrapError(tss.Classify(errors.New("this h2j was already used by another party"), tss.ErrBadMessage), msg.GetFrom()).WithMessages(msg)
 		}
 		h1H2Map[h1JHex], h1H2Map[h2JHex] = struct{}{}, struct{}{}
 
@@ -73,13 +68,16 @@ func (round *round2) Start() *tss.Error {
 		_j := j
 		_msg := msg
 
+		start := time.Now()
 		dlnVerifier.VerifyDLNProof1(r1msg, H1j, H2j, NTildej, func(isValid bool) {
+			round.ObserveProof(TaskName, round.number, "dln1", start, isValid)
 			if !isValid {
 				dlnProof1FailCulprits[_j] = _msg.GetFrom()
 			}
 			wg.Done()
 		})
 		dlnVerifier.VerifyDLNProof2(r1msg, H2j, H1j, NTildej, func(isValid bool) {
+			round.ObserveProof(TaskName, round.number, "dln2", start, isValid)
 			if !isValid {
 				dlnProof2FailCulprits[_j] = _msg.GetFrom()
 			}
@@ -89,7 +87,8 @@ func (round *round2) Start() *tss.Error {
 	wg.Wait()
 	for _, culprit := range append(dlnProof1FailCulprits, dlnProof2FailCulprits...) {
 		if culprit != nil {
-			return round.WrapError(errors.New("dln proof verification failed"), culprit)
+			return round.WrapError(tss.Classify(errors.New("dln proof verification failed"), tss.ErrInvalidProof), culprit).
+				WithMessages(round.temp.kgRound1Messages[culprit.Index])
 		}
 	}
 	// save NTilde_j, h1_j, h2_j, ...
@@ -120,8 +119,10 @@ func (round *round2) Start() *tss.Error {
 		}
 		if !round.Params().NoProofFac() {
 			var err error
-			facProof, err = facproof.NewProof(ContextI, round.EC(), round.save.PaillierSK.N, round.save.NTildej[j],
-				round.save.H1j[j], round.save.H2j[j], round.save.PaillierSK.P, round.save.PaillierSK.Q, round.Rand())
+			common.RunOn(round.Executor(), func() {
+				facProof, err = facproof.NewProof(ContextI, round.EC(), round.save.PaillierSK.N, round.save.NTildej[j],
+					round.save.H1j[j], round.save.H2j[j], round.save.PaillierSK.P, round.save.PaillierSK.Q, round.Rand())
+			})
 			if err != nil {
 				return round.WrapError(err, round.PartyID())
 			}
@@ -140,8 +141,10 @@ func (round *round2) Start() *tss.Error {
 	modProof := &modproof.ProofMod{W: zero, X: *new([80]*big.Int), A: zero, B: zero, Z: *new([80]*big.Int)}
 	if !round.Parameters.NoProofMod() {
 		var err error
-		modProof, err = modproof.NewProof(ContextI, round.save.PaillierSK.N,
-			round.save.PaillierSK.P, round.save.PaillierSK.Q, round.Rand())
+		common.RunOn(round.Executor(), func() {
+			modProof, err = modproof.NewProof(ContextI, round.save.PaillierSK.N,
+				round.save.PaillierSK.P, round.save.PaillierSK.Q, round.Rand())
+		})
 		if err != nil {
 			return round.WrapError(err, round.PartyID())
 		}
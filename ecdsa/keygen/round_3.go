@@ -31,18 +31,6 @@ func (round *round3) Start() *tss.Error {
 	Ps := round.Parties().IDs()
 	PIdx := round.PartyID().Index
 
-	// 1,9. calculate xi
-	xi := new(big.Int).Set(round.temp.shares[PIdx].Share)
-	for j := range Ps {
-		if j == PIdx {
-			continue
-		}
-		r2msg1 := round.temp.kgRound2Message1s[j].Content().(*KGRound2Message1)
-		share := r2msg1.UnmarshalShare()
-		xi = new(big.Int).Add(xi, share)
-	}
-	round.save.Xi = new(big.Int).Mod(xi, round.Params().EC().Params().N)
-
 	// 2-3.
 	Vc := make(vss.Vs, round.Threshold()+1)
 	for c := range Vc {
@@ -53,84 +41,101 @@ func (round *round3) Start() *tss.Error {
 	type vssOut struct {
 		unWrappedErr error
 		pjVs         vss.Vs
+		// the messages that the error is about, which are its evidence
+		msgs []tss.Message
 	}
 	chs := make([]chan vssOut, len(Ps))
+	// the dealers whose shares failed verification, to be accused in the complaint round
+	disputed := make([]bool, len(Ps))
 	for i := range chs {
 		if i == PIdx {
 			continue
 		}
-		chs[i] = make(chan vssOut)
+		// buffered so that the verifications never wait on the round
+		chs[i] = make(chan vssOut, 1)
 	}
+	exec := round.Executor()
 	for j := range Ps {
 		if j == PIdx {
 			continue
 		}
 		ContextJ := common.AppendBigIntToBytesSlice(round.temp.ssid, big.NewInt(int64(j)))
 		// 6-8.
-		go func(j int, ch chan<- vssOut) {
+		ch := chs[j]
+		exec.Go(func() {
 			// 4-9.
 			KGCj := round.temp.KGCs[j]
-			r2msg2 := round.temp.kgRound2Message2s[j].Content().(*KGRound2Message2)
+			cmtMsg, shareMsg, deCmtMsg := round.temp.kgRound1Messages[j], round.temp.kgRound2Message1s[j], round.temp.kgRound2Message2s[j]
+			r2msg2 := deCmtMsg.Content().(*KGRound2Message2)
 			KGDj := r2msg2.UnmarshalDeCommitment()
 			cmtDeCmt := commitments.HashCommitDecommit{C: KGCj, D: KGDj}
 			ok, flatPolyGs := cmtDeCmt.DeCommit()
 			if !ok || flatPolyGs == nil {
-				ch <- vssOut{errors.New("de-commitment verify failed"), nil}
+				ch <- vssOut{tss.Classify(errors.New("de-commitment verify failed"), tss.ErrCommitmentMismatch), nil, []tss.Message{cmtMsg, deCmtMsg}}
 				return
 			}
 			PjVs, err := crypto.UnFlattenECPoints(round.Params().EC(), flatPolyGs)
 			if err != nil {
-				ch <- vssOut{err, nil}
+				ch <- vssOut{err, nil, []tss.Message{deCmtMsg}}
 				return
 			}
 			modProof, err := r2msg2.UnmarshalModProof()
 			if err != nil && round.Parameters.NoProofMod() {
 				// For old parties, the modProof could be not exist
 				// Not return error for compatibility reason
-				common.Logger.Warningf("modProof not exist:%s", Ps[j])
+				round.logger().Warn("modProof does not exist", "from", Ps[j].String())
 			} else {
 				if err != nil {
-					ch <- vssOut{errors.New("modProof verify failed"), nil}
+					ch <- vssOut{tss.Classify(errors.New("modProof verify failed"), tss.ErrInvalidProof), nil, []tss.Message{deCmtMsg}}
 					return
 				}
-				if ok = modProof.Verify(ContextJ, round.save.PaillierPKs[j].N); !ok {
-					ch <- vssOut{errors.New("modProof verify failed"), nil}
+				if ok = round.verifyProof("mod", func() bool {
+					return modProof.Verify(ContextJ, round.save.PaillierPKs[j].N, exec)
+				}); !ok {
+					ch <- vssOut{tss.Classify(errors.New("modProof verify failed"), tss.ErrInvalidProof), nil, []tss.Message{deCmtMsg}}
 					return
 				}
 			}
-			r2msg1 := round.temp.kgRound2Message1s[j].Content().(*KGRound2Message1)
+			r2msg1 := shareMsg.Content().(*KGRound2Message1)
 			PjShare := vss.Share{
 				Threshold: round.Threshold(),
-				ID:        round.PartyID().KeyInt(),
+				ID:        round.save.ShareID,
 				Share:     r2msg1.UnmarshalShare(),
 			}
 			if ok = PjShare.Verify(round.Params().EC(), round.Threshold(), PjVs); !ok {
-				ch <- vssOut{errors.New("vss verify failed"), nil}
-				return
+				if !round.Complaints() {
+					ch <- vssOut{tss.Classify(errors.New("vss verify failed"), tss.ErrVSSFailure), nil, []tss.Message{shareMsg, cmtMsg, deCmtMsg}}
+					return
+				}
+				// the dealer is accused in the complaint round, where it must reveal the share to all parties
+				round.logger().Warn("vss verify failed; the dealer will be accused", "from", Ps[j].String())
+				disputed[j] = true
 			}
 			facProof, err := r2msg1.UnmarshalFacProof()
 			if err != nil && round.NoProofFac() {
 				// For old parties, the facProof could be not exist
 				// Not return error for compatibility reason
-				common.Logger.Warningf("facProof not exist:%s", Ps[j])
+				round.logger().Warn("facProof does not exist", "from", Ps[j].String())
 			} else {
 				if err != nil {
-					ch <- vssOut{errors.New("facProof verify failed"), nil}
+					ch <- vssOut{tss.Classify(errors.New("facProof verify failed"), tss.ErrInvalidProof), nil, []tss.Message{shareMsg}}
 					return
 				}
-				if ok = facProof.Verify(ContextJ, round.EC(), round.save.PaillierPKs[j].N, round.save.NTildei,
-					round.save.H1i, round.save.H2i); !ok {
-					ch <- vssOut{errors.New("facProof verify failed"), nil}
+				if ok = round.verifyProof("fac", func() bool {
+					return facProof.Verify(ContextJ, round.EC(), round.save.PaillierPKs[j].N, round.save.NTildei,
+						round.save.H1i, round.save.H2i)
+				}); !ok {
+					ch <- vssOut{tss.Classify(errors.New("facProof verify failed"), tss.ErrInvalidProof), nil, []tss.Message{shareMsg}}
 					return
 				}
 			}
 
 			// (9) handled above
-			ch <- vssOut{nil, PjVs}
-		}(j, chs[j])
+			ch <- vssOut{nil, PjVs, nil}
+		})
 	}
 
-	// consume unbuffered channels (end the goroutines)
+	// consume the channels (wait for the verifications)
 	vssResults := make([]vssOut, len(Ps))
 	{
 		culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
@@ -146,14 +151,41 @@ func (round *round3) Start() *tss.Error {
 		}
 		var multiErr error
 		if len(culprits) > 0 {
+			var msgs []tss.Message
 			for _, vssResult := range vssResults {
 				if vssResult.unWrappedErr != nil {
 					multiErr = multierror.Append(multiErr, vssResult.unWrappedErr)
+					msgs = append(msgs, vssResult.msgs...)
 				}
 			}
-			return round.WrapError(multiErr, culprits...)
+			return round.WrapError(multiErr, culprits...).WithMessages(msgs...)
+		}
+	}
+
+	// 1,9. calculate xi; the shares of the dealers that this party accuses are added once they have been revealed
+	xi := new(big.Int).Set(round.temp.shares[PIdx].Share)
+	var complaints []int
+	for j := range Ps {
+		if j == PIdx {
+			continue
+		}
+		if disputed[j] {
+			complaints = append(complaints, j)
+			continue
 		}
+		r2msg1 := round.temp.kgRound2Message1s[j].Content().(*KGRound2Message1)
+		share := r2msg1.UnmarshalShare()
+		xi = new(big.Int).Add(xi, share)
 	}
+	round.save.Xi = new(big.Int).Mod(xi, round.Params().EC().Params().N)
+	if round.Complaints() {
+		round.temp.dealerVs = make([]vss.Vs, len(Ps))
+		for j := range Ps {
+			round.temp.dealerVs[j] = vssResults[j].pjVs
+		}
+		round.temp.dealerVs[PIdx] = round.temp.vs
+	}
+
 	{
 		var err error
 		culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
@@ -171,7 +203,7 @@ func (round *round3) Start() *tss.Error {
 			}
 		}
 		if len(culprits) > 0 {
-			return round.WrapError(errors.New("adding PjVs[c] to Vc[c] resulted in a point not on the curve"), culprits...)
+			return round.WrapError(tss.Classify(errors.New("adding PjVs[c] to Vc[c] resulted in a point not on the curve"), tss.ErrVSSFailure), culprits...)
 		}
 	}
 
@@ -183,7 +215,7 @@ func (round *round3) Start() *tss.Error {
 		bigXj := round.save.BigXj
 		for j := 0; j < round.PartyCount(); j++ {
 			Pj := round.Parties().IDs()[j]
-			kj := Pj.KeyInt()
+			kj := round.save.Ks[j]
 			BigXj := Vc[0]
 			z := new(big.Int).SetInt64(int64(1))
 			for c := 1; c <= round.Threshold(); c++ {
@@ -196,7 +228,7 @@ func (round *round3) Start() *tss.Error {
 			bigXj[j] = BigXj
 		}
 		if len(culprits) > 0 {
-			return round.WrapError(errors.New("adding Vc[c].ScalarMult(z) to BigXj resulted in a point not on the curve"), culprits...)
+			return round.WrapError(tss.Classify(errors.New("adding Vc[c].ScalarMult(z) to BigXj resulted in a point not on the curve"), tss.ErrVSSFailure), culprits...)
 		}
 		round.save.BigXj = bigXj
 	}
@@ -209,12 +241,12 @@ func (round *round3) Start() *tss.Error {
 	round.save.ECDSAPub = ecdsaPubKey
 
 	// PRINT public key & private share
-	common.Logger.Debugf("%s public key: %x", round.PartyID(), ecdsaPubKey)
+	round.logger().Debug("public key", "x", ecdsaPubKey.X(), "y", ecdsaPubKey.Y())
 
 	// BROADCAST paillier proof for Pi
-	ki := round.PartyID().KeyInt()
-	proof := round.save.PaillierSK.Proof(ki, ecdsaPubKey)
-	r3msg := NewKGRound3Message(round.PartyID(), proof)
+	ki := round.save.ShareID
+	proof := round.save.PaillierSK.Proof(ki, ecdsaPubKey, round.Executor())
+	r3msg := NewKGRound3Message(round.PartyID(), proof, complaints)
 	round.temp.kgRound3Messages[PIdx] = r3msg
 	round.out <- r3msg
 	return nil
@@ -8,9 +8,9 @@ package keygen
 
 import (
 	"errors"
+	"math/big"
+	"time"
 
-	"github.com/SafeMPC/tss-lib/common"
-	"github.com/SafeMPC/tss-lib/crypto/paillier"
 	"github.com/SafeMPC/tss-lib/tss"
 )
 
@@ -24,7 +24,7 @@ func (round *round4) Start() *tss.Error {
 
 	i := round.PartyID().Index
 	Ps := round.Parties().IDs()
-	PIDs := Ps.Keys()
+	ks := round.save.Ks
 	ecdsaPub := round.save.ECDSAPub
 
 	// 1-3. (concurrent)
@@ -32,26 +32,31 @@ func (round *round4) Start() *tss.Error {
 	r3msgs := round.temp.kgRound3Messages
 	chs := make([]chan bool, len(r3msgs))
 	for i := range chs {
-		chs[i] = make(chan bool)
+		// buffered so that the verifications never wait on the round
+		chs[i] = make(chan bool, 1)
 	}
+	exec := round.Executor()
 	for j, msg := range round.temp.kgRound3Messages {
 		if j == i {
 			continue
 		}
 		r3msg := msg.Content().(*KGRound3Message)
-		go func(prf paillier.Proof, j int, ch chan<- bool) {
+		prf, ch := r3msg.UnmarshalProofInts(), chs[j]
+		exec.Go(func() {
 			ppk := round.save.PaillierPKs[j]
-			ok, err := prf.Verify(ppk.N, PIDs[j], ecdsaPub)
+			start := time.Now()
+			ok, err := prf.Verify(ppk.N, ks[j], ecdsaPub, exec)
+			round.ObserveProof(TaskName, round.number, "paillier", start, ok && err == nil)
 			if err != nil {
-				common.Logger.Error(round.WrapError(err, Ps[j]).Error())
+				round.logger().Error("paillier proof could not be verified", "from", Ps[j].String(), "err", err)
 				ch <- false
 				return
 			}
 			ch <- ok
-		}(r3msg.UnmarshalProofInts(), j, chs[j])
+		})
 	}
 
-	// consume unbuffered channels (end the goroutines)
+	// consume the channels (wait for the verifications)
 	for j, ch := range chs {
 		if j == i {
 			round.ok[j] = true
@@ -60,34 +65,125 @@ func (round *round4) Start() *tss.Error {
 		round.ok[j] = <-ch
 	}
 	culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+	var culpritMsgs []tss.Message
 	for j, ok := range round.ok {
 		if !ok {
 			culprits = append(culprits, Ps[j])
-			common.Logger.Warningf("paillier verify failed for party %s", Ps[j])
+			culpritMsgs = append(culpritMsgs, r3msgs[j])
+			round.logger().Warn("paillier verify failed", "from", Ps[j].String())
 			continue
 		}
-		common.Logger.Debugf("paillier verify passed for party %s", Ps[j])
+		round.logger().Debug("paillier verify passed", "from", Ps[j].String())
 
 	}
 	if len(culprits) > 0 {
-		return round.WrapError(errors.New("paillier verify failed"), culprits...)
+		return round.WrapError(tss.Classify(errors.New("paillier verify failed"), tss.ErrInvalidProof), culprits...).WithMessages(culpritMsgs...)
 	}
 
-	round.end <- round.save
+	// collect the complaints of all parties; without any, keygen is finished
+	complaints, err := round.collectComplaints()
+	if err != nil {
+		return err
+	}
+	if len(complaints) == 0 {
+		round.temp.zeroize()
+		round.end <- round.save
+		return nil
+	}
+	round.temp.complaints = complaints
 
+	// every accused dealer reveals the disputed shares to all parties; the others send nothing in this round
+	for j := range round.ok {
+		round.ok[j] = !round.isAccused(j)
+	}
+	var accusers []int
+	var shares []*big.Int
+	for _, c := range complaints {
+		if c.Dealer == i {
+			accusers = append(accusers, c.Accuser)
+			shares = append(shares, round.temp.shares[c.Accuser].Share)
+		}
+	}
+	if len(accusers) > 0 {
+		round.logger().Warn("revealing the shares disputed by complaints", "accusers", accusers)
+		r4msg := NewKGRound4Message(round.PartyID(), accusers, shares)
+		round.temp.kgRound4Messages[i] = r4msg
+		round.ok[i] = true
+		round.out <- r4msg
+	}
 	return nil
 }
 
+// collectComplaints returns the complaints in the round 3 messages of all parties, ordered by accuser
+func (round *round4) collectComplaints() ([]complaint, *tss.Error) {
+	var complaints []complaint
+	Ps := round.Parties().IDs()
+	for a, msg := range round.temp.kgRound3Messages {
+		dealers := msg.Content().(*KGRound3Message).UnmarshalComplaints()
+		if len(dealers) == 0 {
+			continue
+		}
+		if !round.Complaints() {
+			return nil, round.WrapError(tss.Classify(errors.New("received complaints but complaints are not enabled"), tss.ErrBadMessage), Ps[a]).WithMessages(msg)
+		}
+		seen := make(map[int]bool, len(dealers))
+		for _, d := range dealers {
+			if d < 0 || len(Ps) <= d || d == a || seen[d] {
+				return nil, round.WrapError(tss.Classify(errors.New("received an invalid complaint"), tss.ErrBadMessage), Ps[a]).WithMessages(msg)
+			}
+			seen[d] = true
+			round.logger().Warn("received a complaint", "accuser", Ps[a].String(), "dealer", Ps[d].String())
+			complaints = append(complaints, complaint{Accuser: a, Dealer: d})
+		}
+	}
+	return complaints, nil
+}
+
+// isAccused reports whether the party is the dealer in any of the complaints
+func (round *round4) isAccused(j int) bool {
+	for _, c := range round.temp.complaints {
+		if c.Dealer == j {
+			return true
+		}
+	}
+	return false
+}
+
 func (round *round4) CanAccept(msg tss.ParsedMessage) bool {
-	// not expecting any incoming messages in this round
+	if _, ok := msg.Content().(*KGRound4Message); ok {
+		return msg.IsBroadcast()
+	}
 	return false
 }
 
 func (round *round4) Update() (bool, *tss.Error) {
-	// not expecting any incoming messages in this round
-	return false, nil
+	if len(round.temp.complaints) == 0 {
+		// not expecting any incoming messages in this round
+		return false, nil
+	}
+	ret := true
+	for j, msg := range round.temp.kgRound4Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			ret = false
+			continue
+		}
+		// the revealed shares are verified in round 5
+		round.ok[j] = true
+	}
+	return ret, nil
 }
 
 func (round *round4) NextRound() tss.Round {
-	return nil // finished!
+	if len(round.temp.complaints) == 0 {
+		return nil // finished!
+	}
+	round.started = false
+	return &round5{round}
+}
+
+func (round *round4) IsFinal() bool {
+	return len(round.temp.complaints) == 0
 }
@@ -0,0 +1,120 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/SafeMPC/tss-lib/crypto/vss"
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+// round5 resolves the complaints: it is only run when some party accused a dealer in round 3. Every party verifies
+// the revealed shares against the broadcast commitments of their dealers, so all honest parties reach the same verdict.
+func (round *round5) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 5
+	round.started = true
+	round.resetOK()
+
+	i := round.PartyID().Index
+	Ps := round.Parties().IDs()
+
+	// a dealer that revealed an invalid share, or none, for any of its accusers is a culprit
+	revealed := make([]map[int]*big.Int, len(Ps))
+	culprits := make([]*tss.PartyID, 0, len(Ps))
+	for _, c := range round.temp.complaints {
+		if revealed[c.Dealer] == nil {
+			revealed[c.Dealer] = round.temp.kgRound4Messages[c.Dealer].Content().(*KGRound4Message).UnmarshalShares()
+		}
+		share, ok := revealed[c.Dealer][c.Accuser]
+		if ok {
+			revealedShare := vss.Share{
+				Threshold: round.Threshold(),
+				ID:        round.save.Ks[c.Accuser],
+				Share:     share,
+			}
+			ok = round.verifyProof("vss", func() bool {
+				return revealedShare.Verify(round.EC(), round.Threshold(), round.temp.dealerVs[c.Dealer])
+			})
+		}
+		if !ok {
+			round.logger().Warn("the dealer revealed an invalid share", "accuser", Ps[c.Accuser].String(), "dealer", Ps[c.Dealer].String())
+			culprits = appendCulprit(culprits, Ps[c.Dealer])
+		}
+	}
+	if len(culprits) > 0 {
+		// the evidence of each dealer is its revealed shares and the commitment that they were checked against
+		var msgs []tss.Message
+		for _, culprit := range culprits {
+			j := culprit.Index
+			msgs = append(msgs, round.temp.kgRound4Messages[j], round.temp.kgRound1Messages[j], round.temp.kgRound2Message2s[j])
+		}
+		return round.WrapError(tss.Classify(errors.New("a dealer revealed an invalid share in the complaint round"), tss.ErrVSSFailure), culprits...).
+			WithMessages(msgs...)
+	}
+
+	// every revealed share is valid, so the complaints are resolved: an accuser uses the revealed share instead, and is
+	// reported as a false accuser along with its complaint and the revealed shares that refute it
+	xi := new(big.Int).Set(round.save.Xi)
+	accusers, dealers := make([]*tss.PartyID, 0, len(Ps)), make([]*tss.PartyID, 0, len(Ps))
+	for _, c := range round.temp.complaints {
+		round.logger().Warn("the complaint was refuted by the revealed share", "accuser", Ps[c.Accuser].String(), "dealer", Ps[c.Dealer].String())
+		if c.Accuser == i {
+			xi.Add(xi, revealed[c.Dealer][i])
+		}
+		accusers = appendCulprit(accusers, Ps[c.Accuser])
+		dealers = appendCulprit(dealers, Ps[c.Dealer])
+	}
+	round.save.Xi = xi.Mod(xi, round.EC().Params().N)
+	var msgs []tss.Message
+	for _, accuser := range accusers {
+		msgs = append(msgs, round.temp.kgRound3Messages[accuser.Index])
+	}
+	for _, dealer := range dealers {
+		j := dealer.Index
+		msgs = append(msgs, round.temp.kgRound4Messages[j], round.temp.kgRound1Messages[j], round.temp.kgRound2Message2s[j])
+	}
+	round.temp.falseComplaints = round.WrapError(tss.Classify(errors.New("a party complained about a valid share"), tss.ErrFalseComplaint), accusers...).
+		WithMessages(msgs...)
+
+	round.temp.zeroize()
+	round.end <- round.save
+
+	return nil
+}
+
+func (round *round5) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *round5) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *round5) NextRound() tss.Round {
+	return nil // finished!
+}
+
+func (round *round5) IsFinal() bool {
+	return true
+}
+
+// appendCulprit appends the party to the culprits unless it is one already
+func appendCulprit(culprits []*tss.PartyID, pID *tss.PartyID) []*tss.PartyID {
+	for _, culprit := range culprits {
+		if culprit == pID {
+			return culprits
+		}
+	}
+	return append(culprits, pID)
+}
@@ -7,7 +7,9 @@
 package keygen
 
 import (
+	"errors"
 	"math/big"
+	"time"
 
 	"github.com/SafeMPC/tss-lib/common"
 	"github.com/SafeMPC/tss-lib/tss"
@@ -40,6 +42,9 @@ type (
 	round4 struct {
 		*round3
 	}
+	round5 struct {
+		*round4
+	}
 )
 
 var (
@@ -47,6 +52,7 @@ var (
 	_ tss.Round = (*round2)(nil)
 	_ tss.Round = (*round3)(nil)
 	_ tss.Round = (*round4)(nil)
+	_ tss.Round = (*round5)(nil)
 )
 
 // ----- //
@@ -89,6 +95,21 @@ func (round *base) WrapError(err error, culprits ...*tss.PartyID) *tss.Error {
 	return tss.NewError(err, TaskName, round.number, round.PartyID(), culprits...)
 }
 
+// State is captured in a snapshot of the party
+func (round *base) State() tss.RoundState {
+	return tss.RoundState{Number: round.number, Started: round.started, OK: [][]bool{append([]bool{}, round.ok...)}}
+}
+
+// Restore puts a round created by NextRound() back into the state captured in a snapshot
+func (round *base) Restore(state tss.RoundState) error {
+	if len(state.OK) != 1 || len(state.OK[0]) != len(round.ok) {
+		return errors.New("the round state does not match the number of parties")
+	}
+	round.number, round.started = state.Number, state.Started
+	copy(round.ok, state.OK[0])
+	return nil
+}
+
 // ----- //
 
 // `ok` tracks parties which have been verified by Update()
@@ -98,10 +119,35 @@ func (round *base) resetOK() {
 	}
 }
 
+// logger returns the party's Logger enriched with the task and round
+func (round *base) logger() tss.Logger {
+	return round.Logger().With("task", TaskName, "round", round.number)
+}
+
+// verifyProof runs and times the verification of a proof, reporting it to the party's Observer
+func (round *base) verifyProof(proof string, verify func() bool) bool {
+	start := time.Now()
+	ok := verify()
+	round.ObserveProof(TaskName, round.number, proof, start, ok)
+	return ok
+}
+
 // get ssid from local params
 func (round *base) getSSID() ([]byte, error) {
 	ssidList := []*big.Int{round.EC().Params().P, round.EC().Params().N, round.EC().Params().Gx, round.EC().Params().Gy} // ec curve
 	ssidList = append(ssidList, round.Parties().IDs().Keys()...)
+	// the modulus size, unless it is the default, so that the SSID of the default size remains that of older releases
+	if bits := round.ModulusBits(); bits != tss.DefaultModulusBits {
+		ssidList = append(ssidList, big.NewInt(int64(bits)))
+	}
+	// the share IDs, if they are not the parties' keys, which are hashed already
+	if round.CustomShareIDs() {
+		ssidList = append(ssidList, round.ShareIDs()...)
+	}
+	// likewise whether complaints are enabled
+	if round.Complaints() {
+		ssidList = append(ssidList, new(big.Int).SetBytes([]byte("complaints")))
+	}
 	ssidList = append(ssidList, big.NewInt(int64(round.number))) // round number
 	ssidList = append(ssidList, round.temp.ssidNonce)
 	ssid := common.SHA512_256i(ssidList...).Bytes()
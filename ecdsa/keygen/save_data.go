@@ -9,10 +9,13 @@ package keygen
 import (
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"math/big"
 
+	"github.com/SafeMPC/tss-lib/common"
 	"github.com/SafeMPC/tss-lib/crypto"
 	"github.com/SafeMPC/tss-lib/crypto/paillier"
+	"github.com/SafeMPC/tss-lib/crypto/vss"
 	"github.com/SafeMPC/tss-lib/tss"
 )
 
@@ -45,6 +48,9 @@ type (
 		BigXj       []*crypto.ECPoint     // Xj
 		PaillierPKs []*paillier.PublicKey // pkj
 
+		// the bit length of the Paillier moduli and NTildes; zero in save data that predates configurable sizes
+		ModulusBits int
+
 		// used for test assertions (may be discarded)
 		ECDSAPub *crypto.ECPoint // y
 	}
@@ -76,8 +82,46 @@ func (preParams LocalPreParams) ValidateWithProof() bool {
 		preParams.Q != nil
 }
 
+// HasModulusBits reports whether the Paillier modulus and NTilde have the given bit length
+func (preParams LocalPreParams) HasModulusBits(bits int) bool {
+	return preParams.PaillierSK != nil && preParams.PaillierSK.N.BitLen() == bits &&
+		preParams.NTildei != nil && preParams.NTildei.BitLen() == bits
+}
+
+// ModulusBitLen returns the bit length of the Paillier moduli and NTildes of the key. Save data that does not record
+// it predates configurable sizes and was generated with tss.DefaultModulusBits.
+func (save LocalPartySaveData) ModulusBitLen() int {
+	if save.ModulusBits == 0 {
+		return tss.DefaultModulusBits
+	}
+	return save.ModulusBits
+}
+
+// ValidateModuli checks that the Paillier modulus and NTilde of every party have the bit length recorded in the save
+// data; it returns the index of the first party whose moduli do not, or -1
+func (save LocalPartySaveData) ValidateModuli() (int, error) {
+	bits := save.ModulusBitLen()
+	for j := range save.PaillierPKs {
+		if save.PaillierPKs[j] == nil || save.PaillierPKs[j].N == nil || save.PaillierPKs[j].N.BitLen() != bits {
+			return j, fmt.Errorf("the paillier modulus of party %d does not have %d bits", j, bits)
+		}
+		if save.NTildej[j] == nil || save.NTildej[j].BitLen() != bits {
+			return j, fmt.Errorf("the NTilde of party %d does not have %d bits", j, bits)
+		}
+	}
+	return -1, nil
+}
+
 // BuildLocalSaveDataSubset re-creates the LocalPartySaveData to contain data for only the list of signing parties.
-func BuildLocalSaveDataSubset(sourceData LocalPartySaveData, sortedIDs tss.SortedPartyIDs) LocalPartySaveData {
+// The parties are found by their share IDs, given in the order of sortedIDs if the key was dealt with explicit share IDs
+// (see tss.Parameters.SetShareIDs); otherwise they are found by their keys.
+func BuildLocalSaveDataSubset(sourceData LocalPartySaveData, sortedIDs tss.SortedPartyIDs, shareIDs ...*big.Int) LocalPartySaveData {
+	if len(shareIDs) == 0 {
+		shareIDs = sortedIDs.Keys()
+	}
+	if len(shareIDs) != sortedIDs.Len() {
+		panic(errors.New("BuildLocalSaveDataSubset: the number of share IDs does not match the number of parties"))
+	}
 	keysToIndices := make(map[string]int, len(sourceData.Ks))
 	for j, kj := range sourceData.Ks {
 		keysToIndices[hex.EncodeToString(kj.Bytes())] = j
@@ -86,8 +130,9 @@ func BuildLocalSaveDataSubset(sourceData LocalPartySaveData, sortedIDs tss.Sorte
 	newData.LocalPreParams = sourceData.LocalPreParams
 	newData.LocalSecrets = sourceData.LocalSecrets
 	newData.ECDSAPub = sourceData.ECDSAPub
-	for j, id := range sortedIDs {
-		savedIdx, ok := keysToIndices[hex.EncodeToString(id.Key)]
+	newData.ModulusBits = sourceData.ModulusBits
+	for j, shareID := range shareIDs {
+		savedIdx, ok := keysToIndices[hex.EncodeToString(shareID.Bytes())]
 		if !ok {
 			panic(errors.New("BuildLocalSaveDataSubset: unable to find a signer party in the local save data"))
 		}
@@ -100,3 +145,121 @@ func BuildLocalSaveDataSubset(sourceData LocalPartySaveData, sortedIDs tss.Sorte
 	}
 	return newData
 }
+
+// VerifySaveDataSet checks that the save data of the parties of one key are consistent with each other: every party
+// has the same Ks, BigXj, ECDSAPub, NTildej, H1j, H2j and PaillierPKs; any threshold+1 of the BigXj interpolate to
+// ECDSAPub; and the secret share and the Paillier and NTilde secrets of each party match the published values.
+// The set may hold the save data of only some of the parties, such as those that one host keeps; a set that must be
+// complete is checked with VerifyCompleteSaveDataSet.
+func VerifySaveDataSet(threshold int, saves []LocalPartySaveData) error {
+	if len(saves) == 0 {
+		return errors.New("there is no save data to verify")
+	}
+	first := saves[0]
+	if first.ECDSAPub == nil {
+		return errors.New("the save data has no public key")
+	}
+	ec := first.ECDSAPub.Curve()
+	if len(first.BigXj) != len(first.Ks) || len(first.NTildej) != len(first.Ks) || len(first.H1j) != len(first.Ks) ||
+		len(first.H2j) != len(first.Ks) || len(first.PaillierPKs) != len(first.Ks) {
+		return errors.New("the save data does not have the public data of every party")
+	}
+	if err := vss.CheckPublicShares(ec, threshold, first.Ks, first.BigXj, first.ECDSAPub); err != nil {
+		return err
+	}
+	if _, err := first.ValidateModuli(); err != nil {
+		return err
+	}
+	seen := make(map[int]bool, len(saves))
+	for i, save := range saves {
+		if err := save.samePublicData(first); err != nil {
+			return fmt.Errorf("the save data %d differs from the save data 0: %w", i, err)
+		}
+		j := common.IndexOfInt(save.Ks, save.ShareID)
+		if j < 0 {
+			return fmt.Errorf("the share ID of the save data %d is not one of the Ks", i)
+		}
+		if seen[j] {
+			return fmt.Errorf("the save data %d is of party %d, as is another save data", i, j)
+		}
+		seen[j] = true
+		if save.Xi == nil || !crypto.ScalarBaseMult(ec, save.Xi).Equals(save.BigXj[j]) {
+			return fmt.Errorf("the secret share of party %d does not match its BigXj", j)
+		}
+		if err := save.LocalPreParams.matches(save.PaillierPKs[j], save.NTildej[j], save.H1j[j], save.H2j[j]); err != nil {
+			return fmt.Errorf("the pre-parameters of party %d: %w", j, err)
+		}
+	}
+	return nil
+}
+
+// VerifyCompleteSaveDataSet checks the save data as VerifySaveDataSet does, and that they are those of every party of
+// the key
+func VerifyCompleteSaveDataSet(threshold int, saves []LocalPartySaveData) error {
+	if err := VerifySaveDataSet(threshold, saves); err != nil {
+		return err
+	}
+	if n := len(saves[0].Ks); len(saves) != n {
+		return fmt.Errorf("the set holds the save data of %d of the %d parties", len(saves), n)
+	}
+	return nil
+}
+
+// samePublicData checks that the data that every party shares is the same in both save data
+func (save LocalPartySaveData) samePublicData(other LocalPartySaveData) error {
+	switch {
+	case !common.EqualInts(save.Ks, other.Ks):
+		return errors.New("the Ks differ")
+	case !crypto.EqualECPoints(save.BigXj, other.BigXj):
+		return errors.New("the BigXj differ")
+	case !save.ECDSAPub.Equals(other.ECDSAPub):
+		return errors.New("the public keys differ")
+	case !common.EqualInts(save.NTildej, other.NTildej), !common.EqualInts(save.H1j, other.H1j), !common.EqualInts(save.H2j, other.H2j):
+		return errors.New("the NTildej, H1j or H2j differ")
+	case save.ModulusBitLen() != other.ModulusBitLen():
+		return errors.New("the modulus sizes differ")
+	case len(save.PaillierPKs) != len(other.PaillierPKs):
+		return errors.New("the PaillierPKs differ")
+	}
+	for j, pk := range save.PaillierPKs {
+		if pk == nil || other.PaillierPKs[j] == nil || pk.N == nil || other.PaillierPKs[j].N == nil || pk.N.Cmp(other.PaillierPKs[j].N) != 0 {
+			return errors.New("the PaillierPKs differ")
+		}
+	}
+	return nil
+}
+
+// matches checks that the pre-parameters are consistent and that they are the secrets of the published Paillier
+// public key, NTilde, h1 and h2
+func (preParams LocalPreParams) matches(paillierPK *paillier.PublicKey, NTilde, h1, h2 *big.Int) error {
+	if !preParams.ValidateWithProof() {
+		return errors.New("the pre-parameters are incomplete")
+	}
+	sk := preParams.PaillierSK
+	if sk.N == nil || sk.N.Cmp(paillierPK.N) != 0 {
+		return errors.New("the paillier secret key is not of the published public key")
+	}
+	if new(big.Int).Mul(sk.P, sk.Q).Cmp(sk.N) != 0 {
+		return errors.New("the paillier primes do not multiply to the modulus")
+	}
+	one := big.NewInt(1)
+	if sk.PhiN == nil || sk.PhiN.Cmp(new(big.Int).Mul(new(big.Int).Sub(sk.P, one), new(big.Int).Sub(sk.Q, one))) != 0 {
+		return errors.New("the paillier secret key has a wrong phi(N)")
+	}
+	if preParams.NTildei.Cmp(NTilde) != 0 || preParams.H1i.Cmp(h1) != 0 || preParams.H2i.Cmp(h2) != 0 {
+		return errors.New("the NTilde, h1 or h2 is not the published one")
+	}
+	// NTilde = (2p+1)(2q+1), h2 = h1^alpha and h1 = h2^beta
+	P, Q := new(big.Int).Lsh(preParams.P, 1), new(big.Int).Lsh(preParams.Q, 1)
+	P.Add(P, one)
+	Q.Add(Q, one)
+	if new(big.Int).Mul(P, Q).Cmp(preParams.NTildei) != 0 {
+		return errors.New("the safe primes do not multiply to the NTilde")
+	}
+	modNTilde := common.ModInt(preParams.NTildei)
+	if modNTilde.Exp(preParams.H1i, preParams.Alpha).Cmp(preParams.H2i) != 0 ||
+		modNTilde.Exp(preParams.H2i, preParams.Beta).Cmp(preParams.H1i) != 0 {
+		return errors.New("the alpha or beta does not relate h1 and h2")
+	}
+	return nil
+}
@@ -0,0 +1,253 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"crypto/elliptic"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/SafeMPC/tss-lib/common"
+	"github.com/SafeMPC/tss-lib/crypto"
+	"github.com/SafeMPC/tss-lib/crypto/paillier"
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+const (
+	// SaveDataVersion is the version of the save data storage format written by this release.
+	// Save data of an older version remain readable; those of a newer version are rejected.
+	SaveDataVersion = 1
+
+	saveDataProtocol = "ecdsa"
+)
+
+// MarshalSaveData encodes the save data in the versioned storage format, a PartySaveData, along with the threshold of
+// the key and the IDs of its parties in the order of Ks, which may be nil if they are not to be recorded
+func MarshalSaveData(save LocalPartySaveData, threshold int, parties tss.SortedPartyIDs) ([]byte, error) {
+	pb, err := NewPartySaveData(save, threshold, parties)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(pb)
+}
+
+// UnmarshalSaveData decodes save data in the storage format. Save data of an older version are migrated to the
+// current one; the returned PartySaveData holds the threshold and the party IDs that were recorded with it.
+func UnmarshalSaveData(bz []byte) (LocalPartySaveData, *PartySaveData, error) {
+	pb := new(PartySaveData)
+	if err := proto.Unmarshal(bz, pb); err != nil {
+		return LocalPartySaveData{}, nil, err
+	}
+	save, err := pb.SaveData()
+	if err != nil {
+		return LocalPartySaveData{}, nil, err
+	}
+	return save, pb, nil
+}
+
+// ConvertJSONSaveData converts save data that was persisted with encoding/json to the storage format. The JSON does not
+// hold the threshold or the party IDs, which are given as in MarshalSaveData.
+func ConvertJSONSaveData(bz []byte, threshold int, parties tss.SortedPartyIDs) ([]byte, error) {
+	var save LocalPartySaveData
+	if err := json.Unmarshal(bz, &save); err != nil {
+		return nil, err
+	}
+	return MarshalSaveData(save, threshold, parties)
+}
+
+// ConvertSaveDataToJSON converts save data in the storage format to the JSON that encoding/json produces for
+// LocalPartySaveData. The threshold and the party IDs have no place in the JSON and are left out.
+func ConvertSaveDataToJSON(bz []byte) ([]byte, error) {
+	save, _, err := UnmarshalSaveData(bz)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(save)
+}
+
+// NewPartySaveData creates the storage format of the save data; see MarshalSaveData
+func NewPartySaveData(save LocalPartySaveData, threshold int, parties tss.SortedPartyIDs) (*PartySaveData, error) {
+	if save.ECDSAPub == nil {
+		return nil, errors.New("save data: there is no public key")
+	}
+	curve, ok := tss.GetCurveName(save.ECDSAPub.Curve())
+	if !ok {
+		return nil, errors.New("save data: the curve of the public key is not in the curve registry")
+	}
+	n := len(save.Ks)
+	if len(save.BigXj) != n || len(save.NTildej) != n || len(save.H1j) != n || len(save.H2j) != n || len(save.PaillierPKs) != n {
+		return nil, errors.New("save data: the public data is not of every party")
+	}
+	if parties != nil && len(parties) != n {
+		return nil, errors.New("save data: the number of party IDs does not match the number of parties")
+	}
+	if threshold < 0 || n <= threshold {
+		return nil, errors.New("save data: the threshold is out of range")
+	}
+	index := common.IndexOfInt(save.Ks, save.ShareID)
+	if index < 0 {
+		return nil, errors.New("save data: the share ID is not one of the Ks")
+	}
+	pb := &PartySaveData{
+		Version:     SaveDataVersion,
+		Protocol:    saveDataProtocol,
+		Curve:       string(curve),
+		Threshold:   uint32(threshold),
+		PartyIndex:  uint32(index),
+		Parties:     make([]*PartySaveData_Party, n),
+		PublicKey:   pointToProto(save.ECDSAPub),
+		ModulusBits: uint32(save.ModulusBits),
+		Xi:          intToBytes(save.Xi),
+		ShareId:     intToBytes(save.ShareID),
+		NTilde:      intToBytes(save.NTildei),
+		H1:          intToBytes(save.H1i),
+		H2:          intToBytes(save.H2i),
+		Alpha:       intToBytes(save.Alpha),
+		Beta:        intToBytes(save.Beta),
+		P:           intToBytes(save.P),
+		Q:           intToBytes(save.Q),
+	}
+	if sk := save.PaillierSK; sk != nil {
+		pb.PaillierSk = &PartySaveData_PaillierSecretKey{
+			N:       intToBytes(sk.N),
+			LambdaN: intToBytes(sk.LambdaN),
+			PhiN:    intToBytes(sk.PhiN),
+			P:       intToBytes(sk.P),
+			Q:       intToBytes(sk.Q),
+		}
+	}
+	for j := range pb.Parties {
+		party := &PartySaveData_Party{
+			ShareId: intToBytes(save.Ks[j]),
+			BigX:    pointToProto(save.BigXj[j]),
+			NTilde:  intToBytes(save.NTildej[j]),
+			H1:      intToBytes(save.H1j[j]),
+			H2:      intToBytes(save.H2j[j]),
+		}
+		if save.PaillierPKs[j] != nil {
+			party.PaillierN = intToBytes(save.PaillierPKs[j].N)
+		}
+		if parties != nil {
+			party.Id, party.Moniker, party.Key = parties[j].Id, parties[j].Moniker, parties[j].Key
+		}
+		pb.Parties[j] = party
+	}
+	return pb, nil
+}
+
+// SaveData returns the save data, migrating save data of an older version to the current one
+func (pb *PartySaveData) SaveData() (LocalPartySaveData, error) {
+	if err := migrateSaveData(pb); err != nil {
+		return LocalPartySaveData{}, err
+	}
+	if pb.GetProtocol() != saveDataProtocol {
+		return LocalPartySaveData{}, fmt.Errorf("save data: the save data is of protocol %q", pb.GetProtocol())
+	}
+	ec, ok := tss.GetCurveByName(tss.CurveName(pb.GetCurve()))
+	if !ok {
+		return LocalPartySaveData{}, fmt.Errorf("save data: the curve %q is not in the curve registry", pb.GetCurve())
+	}
+	n := len(pb.GetParties())
+	if n <= int(pb.GetPartyIndex()) || n <= int(pb.GetThreshold()) {
+		return LocalPartySaveData{}, errors.New("save data: the party index or the threshold is out of range")
+	}
+	save := NewLocalPartySaveData(n)
+	var err error
+	if save.ECDSAPub, err = pointFromProto(ec, pb.GetPublicKey()); err != nil {
+		return LocalPartySaveData{}, err
+	}
+	save.ModulusBits = int(pb.GetModulusBits())
+	save.Xi, save.ShareID = bytesToInt(pb.GetXi()), bytesToInt(pb.GetShareId())
+	save.NTildei, save.H1i, save.H2i = bytesToInt(pb.GetNTilde()), bytesToInt(pb.GetH1()), bytesToInt(pb.GetH2())
+	save.Alpha, save.Beta = bytesToInt(pb.GetAlpha()), bytesToInt(pb.GetBeta())
+	save.P, save.Q = bytesToInt(pb.GetP()), bytesToInt(pb.GetQ())
+	if sk := pb.GetPaillierSk(); sk != nil {
+		save.PaillierSK = &paillier.PrivateKey{
+			PublicKey: paillier.PublicKey{N: bytesToInt(sk.GetN())},
+			LambdaN:   bytesToInt(sk.GetLambdaN()),
+			PhiN:      bytesToInt(sk.GetPhiN()),
+			P:         bytesToInt(sk.GetP()),
+			Q:         bytesToInt(sk.GetQ()),
+		}
+	}
+	for j, party := range pb.GetParties() {
+		save.Ks[j] = bytesToInt(party.GetShareId())
+		if save.BigXj[j], err = pointFromProto(ec, party.GetBigX()); err != nil {
+			return LocalPartySaveData{}, err
+		}
+		if N := bytesToInt(party.GetPaillierN()); N != nil {
+			save.PaillierPKs[j] = &paillier.PublicKey{N: N}
+		}
+		save.NTildej[j], save.H1j[j], save.H2j[j] = bytesToInt(party.GetNTilde()), bytesToInt(party.GetH1()), bytesToInt(party.GetH2())
+	}
+	return save, nil
+}
+
+// PartyIDs returns the IDs of the parties in the order of Ks, or nil if they were not recorded
+func (pb *PartySaveData) PartyIDs() tss.SortedPartyIDs {
+	return partyIDsFromProto(pb.GetParties())
+}
+
+// migrateSaveData brings save data of an older version to the current one. A change to the format that unset fields
+// cannot express bumps SaveDataVersion and adds the step from the previous version here.
+func migrateSaveData(pb *PartySaveData) error {
+	switch v := pb.GetVersion(); {
+	case v == 0:
+		return errors.New("save data: the version is missing")
+	case SaveDataVersion < v:
+		return fmt.Errorf("save data: unsupported version %d", v)
+	}
+	return nil
+}
+
+func partyIDsFromProto(parties []*PartySaveData_Party) tss.SortedPartyIDs {
+	ids := make(tss.UnSortedPartyIDs, len(parties))
+	for j, party := range parties {
+		if party.GetId() == "" {
+			return nil
+		}
+		ids[j] = tss.NewPartyID(party.GetId(), party.GetMoniker(), new(big.Int).SetBytes(party.GetKey()))
+	}
+	return tss.SortPartyIDs(ids)
+}
+
+func pointToProto(p *crypto.ECPoint) *PartySaveData_ECPoint {
+	if p == nil {
+		return nil
+	}
+	return &PartySaveData_ECPoint{X: p.X().Bytes(), Y: p.Y().Bytes()}
+}
+
+func pointFromProto(ec elliptic.Curve, p *PartySaveData_ECPoint) (*crypto.ECPoint, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return crypto.NewECPoint(ec, new(big.Int).SetBytes(p.GetX()), new(big.Int).SetBytes(p.GetY()))
+}
+
+// intToBytes encodes an integer for an optional field: nil is left unset, while zero is set and empty
+func intToBytes(x *big.Int) []byte {
+	if x == nil {
+		return nil
+	}
+	if bz := x.Bytes(); len(bz) != 0 {
+		return bz
+	}
+	return []byte{}
+}
+
+// bytesToInt decodes an optional field that intToBytes encoded; an unset field is nil
+func bytesToInt(bz []byte) *big.Int {
+	if bz == nil {
+		return nil
+	}
+	return new(big.Int).SetBytes(bz)
+}
@@ -0,0 +1,121 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SafeMPC/tss-lib/crypto"
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+func TestVerifySaveDataSet(t *testing.T) {
+	saves, _, err := LoadKeygenTestFixtures(testParticipants)
+	if err != nil {
+		t.Skip("the test fixtures were not found; run the keygen tests first")
+	}
+	assert.NoError(t, VerifySaveDataSet(testThreshold, saves))
+	assert.NoError(t, VerifySaveDataSet(testThreshold, saves[1:3]), "a subset of the parties is consistent")
+	assert.NoError(t, VerifyCompleteSaveDataSet(testThreshold, saves))
+	assert.Error(t, VerifyCompleteSaveDataSet(testThreshold, saves[1:3]), "a subset of the parties is not complete")
+	assert.Error(t, VerifySaveDataSet(testThreshold-1, saves), "the shares are of a higher degree")
+	assert.Error(t, VerifySaveDataSet(testThreshold, nil))
+
+	tamper := func(i int, f func(save *LocalPartySaveData)) []LocalPartySaveData {
+		tampered := append([]LocalPartySaveData(nil), saves...)
+		f(&tampered[i])
+		return tampered
+	}
+	assert.Error(t, VerifySaveDataSet(testThreshold, tamper(1, func(save *LocalPartySaveData) {
+		save.Xi = new(big.Int).Add(save.Xi, big.NewInt(1))
+	})), "a secret share that does not match its BigXj")
+	assert.Error(t, VerifySaveDataSet(testThreshold, tamper(1, func(save *LocalPartySaveData) {
+		save.BigXj = append([]*crypto.ECPoint(nil), save.BigXj...)
+		save.BigXj[0] = crypto.ScalarBaseMult(save.ECDSAPub.Curve(), big.NewInt(1))
+	})), "a party that has other BigXj")
+	assert.Error(t, VerifySaveDataSet(testThreshold, tamper(2, func(save *LocalPartySaveData) {
+		save.H1j = append([]*big.Int(nil), save.H1j...)
+		save.H1j[0] = save.H2j[0]
+	})), "a party that has another h1")
+	assert.Error(t, VerifySaveDataSet(testThreshold, tamper(3, func(save *LocalPartySaveData) {
+		save.LocalPreParams = saves[0].LocalPreParams
+	})), "the pre-parameters of another party")
+	assert.Error(t, VerifySaveDataSet(testThreshold, tamper(3, func(save *LocalPartySaveData) {
+		save.Alpha = new(big.Int).Add(save.Alpha, big.NewInt(1))
+	})), "an alpha that does not relate h1 and h2")
+	assert.Error(t, VerifySaveDataSet(testThreshold, append(saves, saves[0])), "two save data of the same party")
+}
+
+func TestSaveDataStorage(t *testing.T) {
+	saves, pIDs, err := LoadKeygenTestFixtures(testParticipants)
+	if err != nil {
+		t.Skip("the test fixtures were not found; run the keygen tests first")
+	}
+	for i := range saves {
+		bz, err := os.ReadFile(makeTestFixtureFilePath(tss.S256(), i))
+		if !assert.NoError(t, err) {
+			return
+		}
+		// the conversion from the JSON and back is lossless
+		stored, err := ConvertJSONSaveData(bz, testThreshold, pIDs)
+		if !assert.NoError(t, err) {
+			return
+		}
+		converted, err := ConvertSaveDataToJSON(stored)
+		assert.NoError(t, err)
+		expected, err := json.Marshal(saves[i])
+		assert.NoError(t, err)
+		assert.Equal(t, string(expected), string(converted))
+
+		save, pb, err := UnmarshalSaveData(stored)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, saves[i], save)
+		assert.Equal(t, uint32(SaveDataVersion), pb.GetVersion())
+		assert.Equal(t, string(tss.Secp256k1), pb.GetCurve())
+		assert.Equal(t, uint32(testThreshold), pb.GetThreshold())
+		assert.Equal(t, uint32(i), pb.GetPartyIndex())
+		assert.Equal(t, pIDs.Keys(), pb.PartyIDs().Keys())
+	}
+
+	// a zero integer, such as the Xi of an old committee after a resharing, is kept apart from an unset one
+	zeroed := saves[0]
+	zeroed.Xi = big.NewInt(0)
+	bz, err := MarshalSaveData(zeroed, testThreshold, nil)
+	if assert.NoError(t, err) {
+		save, _, err := UnmarshalSaveData(bz)
+		if assert.NoError(t, err) && assert.NotNil(t, save.Xi, "a zero Xi must not be lost") {
+			assert.Zero(t, save.Xi.Sign())
+		}
+	}
+	zeroed.Xi = nil
+	bz, err = MarshalSaveData(zeroed, testThreshold, nil)
+	if assert.NoError(t, err) {
+		save, _, err := UnmarshalSaveData(bz)
+		assert.NoError(t, err)
+		assert.Nil(t, save.Xi)
+	}
+
+	// save data of a newer version or of another protocol are rejected
+	pb, err := NewPartySaveData(saves[0], testThreshold, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Nil(t, pb.PartyIDs(), "the party IDs were not recorded")
+	pb.Version = SaveDataVersion + 1
+	_, err = pb.SaveData()
+	assert.Error(t, err)
+	pb.Version, pb.Protocol = SaveDataVersion, "eddsa"
+	_, err = pb.SaveData()
+	assert.Error(t, err)
+}
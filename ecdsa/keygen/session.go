@@ -0,0 +1,45 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+// SessionInput is the input of a tss.ProtocolECDSAKeygen session of a tss.SessionManager; it may be omitted
+type SessionInput struct {
+	// PreParams are used instead of generating pre-parameters in the first round, if set
+	PreParams *LocalPreParams
+}
+
+func init() {
+	tss.RegisterProtocol(tss.ProtocolECDSAKeygen, newSessionParty)
+}
+
+func newSessionParty(session *tss.Session, input any) (tss.Party, error) {
+	var in SessionInput
+	switch input := input.(type) {
+	case nil:
+	case SessionInput:
+		in = input
+	case *SessionInput:
+		in = *input
+	default:
+		return nil, fmt.Errorf("%s: expected a keygen.SessionInput, got %T", tss.ProtocolECDSAKeygen, input)
+	}
+	end := tss.SessionEnd[*LocalPartySaveData](session)
+	if in.PreParams == nil {
+		return NewLocalParty(session.Params(), session.Out(), end), nil
+	}
+	if !in.PreParams.ValidateWithProof() {
+		return nil, errors.New("the pre-parameters failed to validate")
+	}
+	return NewLocalParty(session.Params(), session.Out(), end, *in.PreParams), nil
+}
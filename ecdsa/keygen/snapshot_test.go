@@ -0,0 +1,112 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/SafeMPC/tss-lib/crypto"
+	"github.com/SafeMPC/tss-lib/test"
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+func TestSnapshotRestore(t *testing.T) {
+	setUp("info")
+
+	fixtures, pIDs, err := LoadKeygenTestFixtures(testParticipants)
+	if err != nil {
+		t.Skip("the test fixtures were not found; run the keygen tests first")
+	}
+	p2pCtx := tss.NewPeerContext(pIDs)
+	snapshotKey := make([]byte, 32)
+	_, _ = rand.Read(snapshotKey)
+	newParams := func(pID *tss.PartyID) *tss.Parameters {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(pIDs), testThreshold)
+		params.SetSnapshotKey(snapshotKey)
+		params.SetComplaints()
+		params.SetEchoBroadcast()
+		// do not use in untrusted setting
+		params.SetNoProofMod()
+		// do not use in untrusted setting
+		params.SetNoProofFac()
+		return params
+	}
+
+	errCh := make(chan *tss.Error, len(pIDs))
+	outCh := make(chan tss.Message, len(pIDs)*len(pIDs)*4)
+	endCh := make(chan *LocalPartySaveData, len(pIDs))
+	parties := make([]*LocalParty, len(pIDs))
+	for i, pID := range pIDs {
+		parties[i] = NewLocalParty(newParams(pID), outCh, endCh, fixtures[i].LocalPreParams).(*LocalParty)
+		if err := parties[i].Start(); err != nil {
+			assert.FailNow(t, err.Error())
+		}
+	}
+
+	restored := false
+	saves := make([]*LocalPartySaveData, 0, len(pIDs))
+	for len(saves) < len(pIDs) {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case save := <-endCh:
+			saves = append(saves, save)
+		case msg := <-outCh:
+			if dest := msg.GetTo(); dest == nil {
+				for _, P := range parties {
+					test.SharedPartyUpdater(P, msg, errCh)
+				}
+			} else {
+				if r2msg1, ok := msg.(tss.ParsedMessage).Content().(*KGRound2Message1); ok && dest[0].Index == 1 && msg.GetFrom().Index == 0 {
+					// party 0 deals party 1 a bad share, so that party 1 complains
+					tampered := proto.Clone(r2msg1).(*KGRound2Message1)
+					tampered.Share = new(big.Int).Add(r2msg1.UnmarshalShare(), big.NewInt(1)).Bytes()
+					meta := tss.MessageRouting{From: msg.GetFrom(), To: dest}
+					msg = tss.NewMessage(meta, tampered, tss.NewMessageWrapper(meta, tampered))
+				}
+				test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+			}
+			if restored || len(parties[1].temp.complaints) == 0 {
+				continue
+			}
+			// restart party 1 while its complaint is being resolved
+			snapshot, err := parties[1].Snapshot()
+			if !assert.NoError(t, err) {
+				return
+			}
+			ui, complaints, dealerVs := parties[1].temp.ui, parties[1].temp.complaints, parties[1].temp.dealerVs
+			P, err := RestoreLocalParty(newParams(pIDs[1]), snapshot, outCh, endCh)
+			if !assert.NoError(t, err) {
+				return
+			}
+			parties[1] = P.(*LocalParty)
+			assert.Equal(t, 0, ui.Cmp(parties[1].temp.ui), "the restored party must keep its secrets")
+			assert.Equal(t, complaints, parties[1].temp.complaints, "the restored party must keep the complaints")
+			if assert.Len(t, parties[1].temp.dealerVs, len(dealerVs)) {
+				for j, vs := range dealerVs {
+					for c, v := range vs {
+						assert.True(t, v.Equals(parties[1].temp.dealerVs[j][c]), "the restored party must keep the commitments of dealer %d", j)
+					}
+				}
+			}
+			assert.NotNil(t, parties[1].Start(), "a restored party must not start again")
+			restored = true
+		}
+	}
+	assert.True(t, restored)
+	for _, save := range saves {
+		assert.True(t, save.ECDSAPub.Equals(saves[0].ECDSAPub))
+		index, err := save.OriginalIndex()
+		assert.NoError(t, err)
+		assert.True(t, save.BigXj[index].Equals(crypto.ScalarBaseMult(tss.S256(), save.Xi)), "ensure BigX_j == g^x_j")
+	}
+}
@@ -7,6 +7,7 @@
 package keygen
 
 import (
+	"crypto/elliptic"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -29,18 +30,25 @@ const (
 	TestThreshold    = test.TestParticipants / 2
 )
 const (
-	testFixtureDirFormat  = "%s/../../test/_ecdsa_fixtures"
-	testFixtureFileFormat = "keygen_data_%d.json"
+	testFixtureDirFormat      = "%s/../../test/_ecdsa_fixtures"
+	testCurveFixtureDirFormat = "%s/../../test/_ecdsa_%s_fixtures"
+	testFixtureFileFormat     = "keygen_data_%d.json"
 )
 
+// LoadKeygenTestFixtures loads the secp256k1 keygen fixtures
 func LoadKeygenTestFixtures(qty int, optionalStart ...int) ([]LocalPartySaveData, tss.SortedPartyIDs, error) {
+	return LoadKeygenTestFixturesForCurve(tss.S256(), qty, optionalStart...)
+}
+
+// LoadKeygenTestFixturesForCurve loads the keygen fixtures of the given curve
+func LoadKeygenTestFixturesForCurve(curve elliptic.Curve, qty int, optionalStart ...int) ([]LocalPartySaveData, tss.SortedPartyIDs, error) {
 	keys := make([]LocalPartySaveData, 0, qty)
 	start := 0
 	if 0 < len(optionalStart) {
 		start = optionalStart[0]
 	}
 	for i := start; i < qty; i++ {
-		fixtureFilePath := makeTestFixtureFilePath(i)
+		fixtureFilePath := makeTestFixtureFilePath(curve, i)
 		bz, err := ioutil.ReadFile(fixtureFilePath)
 		if err != nil {
 			return nil, nil, errors.Wrapf(err,
@@ -53,12 +61,14 @@ func LoadKeygenTestFixtures(qty int, optionalStart ...int) ([]LocalPartySaveData
 				"could not unmarshal fixture data for party %d located at: %s",
 				i, fixtureFilePath)
 		}
-		for _, kbxj := range key.BigXj {
-			kbxj.SetCurve(tss.S256())
+		if !tss.SameCurve(key.ECDSAPub.Curve(), curve) {
+			return nil, nil, fmt.Errorf("the fixture for party %d located at %s is not on the expected curve", i, fixtureFilePath)
 		}
-		key.ECDSAPub.SetCurve(tss.S256())
 		keys = append(keys, key)
 	}
+	if err := VerifySaveDataSet(TestThreshold, keys); err != nil {
+		return nil, nil, errors.Wrap(err, "the test fixtures are inconsistent")
+	}
 	partyIDs := make(tss.UnSortedPartyIDs, len(keys))
 	for i, key := range keys {
 		pMoniker := fmt.Sprintf("%d", i+start+1)
@@ -68,7 +78,13 @@ func LoadKeygenTestFixtures(qty int, optionalStart ...int) ([]LocalPartySaveData
 	return keys, sortedPIDs, nil
 }
 
+// LoadKeygenTestFixturesRandomSet loads a random set of the secp256k1 keygen fixtures
 func LoadKeygenTestFixturesRandomSet(qty, fixtureCount int) ([]LocalPartySaveData, tss.SortedPartyIDs, error) {
+	return LoadKeygenTestFixturesRandomSetForCurve(tss.S256(), qty, fixtureCount)
+}
+
+// LoadKeygenTestFixturesRandomSetForCurve loads a random set of the keygen fixtures of the given curve
+func LoadKeygenTestFixturesRandomSetForCurve(curve elliptic.Curve, qty, fixtureCount int) ([]LocalPartySaveData, tss.SortedPartyIDs, error) {
 	keys := make([]LocalPartySaveData, 0, qty)
 	plucked := make(map[int]interface{}, qty)
 	for i := 0; len(plucked) < qty; i = (i + 1) % fixtureCount {
@@ -78,7 +94,7 @@ func LoadKeygenTestFixturesRandomSet(qty, fixtureCount int) ([]LocalPartySaveDat
 		}
 	}
 	for i := range plucked {
-		fixtureFilePath := makeTestFixtureFilePath(i)
+		fixtureFilePath := makeTestFixtureFilePath(curve, i)
 		bz, err := ioutil.ReadFile(fixtureFilePath)
 		if err != nil {
 			return nil, nil, errors.Wrapf(err,
@@ -91,12 +107,14 @@ func LoadKeygenTestFixturesRandomSet(qty, fixtureCount int) ([]LocalPartySaveDat
 				"could not unmarshal fixture data for party %d located at: %s",
 				i, fixtureFilePath)
 		}
-		for _, kbxj := range key.BigXj {
-			kbxj.SetCurve(tss.S256())
+		if !tss.SameCurve(key.ECDSAPub.Curve(), curve) {
+			return nil, nil, fmt.Errorf("the fixture for party %d located at %s is not on the expected curve", i, fixtureFilePath)
 		}
-		key.ECDSAPub.SetCurve(tss.S256())
 		keys = append(keys, key)
 	}
+	if err := VerifySaveDataSet(TestThreshold, keys); err != nil {
+		return nil, nil, errors.Wrap(err, "the test fixtures are inconsistent")
+	}
 	partyIDs := make(tss.UnSortedPartyIDs, len(keys))
 	j := 0
 	for i := range plucked {
@@ -120,9 +138,12 @@ func LoadNTildeH1H2FromTestFixture(idx int) (NTildei, h1i, h2i *big.Int, err err
 	return
 }
 
-func makeTestFixtureFilePath(partyIndex int) string {
+func makeTestFixtureFilePath(curve elliptic.Curve, partyIndex int) string {
 	_, callerFileName, _, _ := runtime.Caller(0)
 	srcDirName := filepath.Dir(callerFileName)
 	fixtureDirName := fmt.Sprintf(testFixtureDirFormat, srcDirName)
+	if name, _ := tss.GetCurveName(curve); name != tss.Secp256k1 {
+		fixtureDirName = fmt.Sprintf(testCurveFixtureDirFormat, srcDirName, name)
+	}
 	return fmt.Sprintf("%s/"+testFixtureFileFormat, fixtureDirName, partyIndex)
 }
@@ -7,6 +7,7 @@
 package resharing
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 
@@ -77,10 +78,10 @@ func NewLocalParty(
 	oldPartyCount := len(params.OldParties().IDs())
 	subset := key
 	if params.IsOldCommittee() {
-		subset = keygen.BuildLocalSaveDataSubset(key, params.OldParties().IDs())
+		subset = keygen.BuildLocalSaveDataSubset(key, params.OldParties().IDs(), params.ShareIDs()...)
 	}
 	p := &LocalParty{
-		BaseParty: new(tss.BaseParty),
+		BaseParty: tss.NewBaseParty(out),
 		params:    params,
 		temp:      localTempData{},
 		input:     subset,
@@ -103,6 +104,31 @@ func NewLocalParty(
 	return p
 }
 
+// NewLocalPartyFromPool creates a party that, if it is in the new committee, uses pre-parameters taken from the pool
+// for its new key instead of those of `key`, waiting for the pool until ctx is done if it is empty
+func NewLocalPartyFromPool(
+	ctx context.Context,
+	pool *keygen.PreParamsPool,
+	params *tss.ReSharingParameters,
+	key keygen.LocalPartySaveData,
+	out chan<- tss.Message,
+	end chan<- *keygen.LocalPartySaveData,
+) (tss.Party, error) {
+	p := NewLocalParty(params, key, out, end).(*LocalParty)
+	if params.IsNewCommittee() {
+		if pool.ModulusBits() != params.ModulusBits() {
+			return nil, fmt.Errorf("the pool has pre-parameters with moduli of %d bits, but the party needs %d bits",
+				pool.ModulusBits(), params.ModulusBits())
+		}
+		preParams, err := pool.Take(ctx)
+		if err != nil {
+			return nil, err
+		}
+		p.save.LocalPreParams = *preParams
+	}
+	return p, nil
+}
+
 func (p *LocalParty) FirstRound() tss.Round {
 	return newRound1(p.params, &p.input, &p.save, &p.temp, p.out, p.end)
 }
@@ -115,10 +141,18 @@ func (p *LocalParty) Update(msg tss.ParsedMessage) (ok bool, err *tss.Error) {
 	return tss.BaseUpdate(p, msg, TaskName)
 }
 
+// Abort stops the party, overwrites the secrets in its temp data and drops the messages that it received
+func (p *LocalParty) Abort(reason error) *tss.Error {
+	return tss.BaseAbort(p, TaskName, reason, func() {
+		p.temp.zeroize()
+		p.temp.localMessageStore = localMessageStore{}
+	})
+}
+
 func (p *LocalParty) UpdateFromBytes(wireBytes []byte, from *tss.PartyID, isBroadcast bool) (bool, *tss.Error) {
-	msg, err := tss.ParseWireMessage(wireBytes, from, isBroadcast)
+	msg, err := p.params.ParseWireMessage(wireBytes, from, isBroadcast)
 	if err != nil {
-		return false, p.WrapError(err)
+		return false, tss.WrapParseError(p, err, wireBytes, from, isBroadcast)
 	}
 	return p.Update(msg)
 }
@@ -136,8 +170,8 @@ func (p *LocalParty) ValidateMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 		maxFromIdx = len(p.params.OldParties().IDs()) - 1
 	}
 	if maxFromIdx < msg.GetFrom().Index {
-		return false, p.WrapError(fmt.Errorf("received msg with a sender index too great (%d <= %d)",
-			maxFromIdx, msg.GetFrom().Index), msg.GetFrom())
+		return false, p.WrapError(tss.Classify(fmt.Errorf("received msg with a sender index too great (%d <= %d)",
+			maxFromIdx, msg.GetFrom().Index), tss.ErrBadMessage), msg.GetFrom()).WithMessages(msg)
 	}
 	return true, nil
 }
@@ -150,27 +184,28 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	fromPIdx := msg.GetFrom().Index
 
 	// switch/case is necessary to store any messages beyond current round
-	// this does not handle message replays. we expect the caller to apply replay and spoofing protection.
+	// a repeated message from the same sender is ignored if identical and rejected as equivocation otherwise.
+	var slot *tss.ParsedMessage
 	switch msg.Content().(type) {
 	case *DGRound1Message:
-		p.temp.dgRound1Messages[fromPIdx] = msg
+		slot = &p.temp.dgRound1Messages[fromPIdx]
 	case *DGRound2Message1:
-		p.temp.dgRound2Message1s[fromPIdx] = msg
+		slot = &p.temp.dgRound2Message1s[fromPIdx]
 	case *DGRound2Message2:
-		p.temp.dgRound2Message2s[fromPIdx] = msg
+		slot = &p.temp.dgRound2Message2s[fromPIdx]
 	case *DGRound3Message1:
-		p.temp.dgRound3Message1s[fromPIdx] = msg
+		slot = &p.temp.dgRound3Message1s[fromPIdx]
 	case *DGRound3Message2:
-		p.temp.dgRound3Message2s[fromPIdx] = msg
+		slot = &p.temp.dgRound3Message2s[fromPIdx]
 	case *DGRound4Message1:
-		p.temp.dgRound4Message1s[fromPIdx] = msg
+		slot = &p.temp.dgRound4Message1s[fromPIdx]
 	case *DGRound4Message2:
-		p.temp.dgRound4Message2s[fromPIdx] = msg
+		slot = &p.temp.dgRound4Message2s[fromPIdx]
 	default: // unrecognised message, just ignore!
-		common.Logger.Warningf("unrecognised message ignored: %v", msg)
+		p.params.Logger().Warn("unrecognised message ignored", "task", TaskName, "msg", msg.String())
 		return false, nil
 	}
-	return true, nil
+	return tss.StoreMessageOnce(p, slot, msg)
 }
 
 func (p *LocalParty) PartyID() *tss.PartyID {
@@ -180,3 +215,11 @@ func (p *LocalParty) PartyID() *tss.PartyID {
 func (p *LocalParty) String() string {
 	return fmt.Sprintf("id: %s, %s", p.PartyID(), p.BaseParty.String())
 }
+
+// zeroize overwrites the secrets in the temp data; the party does so itself once it has output its result
+func (temp *localTempData) zeroize() {
+	common.Zeroize(temp.newXi)
+	for _, share := range temp.NewShares {
+		common.Zeroize(share.Share)
+	}
+}
@@ -8,6 +8,7 @@ package resharing_test
 
 import (
 	"crypto/ecdsa"
+	"crypto/elliptic"
 	"fmt"
 	"math/big"
 	"runtime"
@@ -24,6 +25,7 @@ import (
 	"github.com/SafeMPC/tss-lib/ecdsa/signing"
 	"github.com/SafeMPC/tss-lib/test"
 	"github.com/SafeMPC/tss-lib/tss"
+	"github.com/SafeMPC/tss-lib/tss/transport"
 )
 
 const (
@@ -38,15 +40,21 @@ func setUp(level string) {
 }
 
 func TestE2EConcurrent(t *testing.T) {
-	setUp("info")
+	testE2EConcurrent(t, tss.S256())
+}
 
-	// tss.SetCurve(elliptic.P256())
+func TestE2EConcurrentP256(t *testing.T) {
+	testE2EConcurrent(t, tss.P256())
+}
+
+func testE2EConcurrent(t *testing.T, curve elliptic.Curve) {
+	setUp("info")
 
 	threshold, newThreshold := testThreshold, testThreshold
 
 	// PHASE: load keygen fixtures
 	firstPartyIdx, extraParties := 1, 1 // extra can be 0 to N-first
-	oldKeys, oldPIDs, err := keygen.LoadKeygenTestFixtures(testThreshold+1+extraParties+firstPartyIdx, firstPartyIdx)
+	oldKeys, oldPIDs, err := keygen.LoadKeygenTestFixturesForCurve(curve, testThreshold+1+extraParties+firstPartyIdx, firstPartyIdx)
 	assert.NoError(t, err, "should load keygen fixtures")
 
 	// PHASE: resharing
@@ -68,17 +76,22 @@ func TestE2EConcurrent(t *testing.T) {
 	outCh := make(chan tss.Message, bothCommitteesPax)
 	endCh := make(chan *keygen.LocalPartySaveData, bothCommitteesPax)
 
-	updater := test.SharedPartyUpdater
+	hub := transport.NewHub()
+	defer hub.Close()
+	updater := test.SharedTransportUpdater
 
 	// init the old parties first
 	for j, pID := range oldPIDs {
-		params := tss.NewReSharingParameters(tss.S256(), oldP2PCtx, newP2PCtx, pID, testParticipants, threshold, newPCount, newThreshold)
+		params := tss.NewReSharingParameters(curve, oldP2PCtx, newP2PCtx, pID, testParticipants, threshold, newPCount, newThreshold)
 		P := NewLocalParty(params, oldKeys[j], outCh, endCh).(*LocalParty) // discard old key data
 		oldCommittee = append(oldCommittee, P)
+		conn, err := hub.ConnectOldCommittee(P.PartyID())
+		assert.NoError(t, err)
+		go updater(P, conn, errCh)
 	}
 	// init the new parties
 	for j, pID := range newPIDs {
-		params := tss.NewReSharingParameters(tss.S256(), oldP2PCtx, newP2PCtx, pID, testParticipants, threshold, newPCount, newThreshold)
+		params := tss.NewReSharingParameters(curve, oldP2PCtx, newP2PCtx, pID, testParticipants, threshold, newPCount, newThreshold)
 		// do not use in untrusted setting
 		params.SetNoProofMod()
 		// do not use in untrusted setting
@@ -89,6 +102,9 @@ func TestE2EConcurrent(t *testing.T) {
 		}
 		P := NewLocalParty(params, save, outCh, endCh).(*LocalParty)
 		newCommittee = append(newCommittee, P)
+		conn, err := hub.Connect(P.PartyID())
+		assert.NoError(t, err)
+		go updater(P, conn, errCh)
 	}
 
 	// start the new parties; they will wait for messages
@@ -120,19 +136,8 @@ func TestE2EConcurrent(t *testing.T) {
 			return
 
 		case msg := <-outCh:
-			dest := msg.GetTo()
-			if dest == nil {
-				t.Fatal("did not expect a msg to have a nil destination during resharing")
-			}
-			if msg.IsToOldCommittee() || msg.IsToOldAndNewCommittees() {
-				for _, destP := range dest[:len(oldCommittee)] {
-					go updater(oldCommittee[destP.Index], msg, errCh)
-				}
-			}
-			if !msg.IsToOldCommittee() || msg.IsToOldAndNewCommittees() {
-				for _, destP := range dest {
-					go updater(newCommittee[destP.Index], msg, errCh)
-				}
+			if err := hub.Send(msg); err != nil {
+				assert.FailNow(t, err.Error())
 			}
 
 		case save := <-endCh:
@@ -154,7 +159,7 @@ func TestE2EConcurrent(t *testing.T) {
 				for j, key := range newKeys {
 					// xj test: BigXj == xj*G
 					xj := key.Xi
-					gXj := crypto.ScalarBaseMult(tss.S256(), xj)
+					gXj := crypto.ScalarBaseMult(curve, xj)
 					BigXj := key.BigXj[j]
 					assert.True(t, BigXj.Equals(gXj), "ensure BigX_j == g^x_j")
 				}
@@ -175,10 +180,16 @@ signing:
 	signOutCh := make(chan tss.Message, len(signPIDs))
 	signEndCh := make(chan *common.SignatureData, len(signPIDs))
 
+	signHub := transport.NewHub()
+	defer signHub.Close()
+
 	for j, signPID := range signPIDs {
-		params := tss.NewParameters(tss.S256(), signP2pCtx, signPID, len(signPIDs), newThreshold)
+		params := tss.NewParameters(curve, signP2pCtx, signPID, len(signPIDs), newThreshold)
 		P := signing.NewLocalParty(big.NewInt(42), params, signKeys[j], signOutCh, signEndCh).(*signing.LocalParty)
 		signParties = append(signParties, P)
+		conn, err := signHub.Connect(P.PartyID())
+		assert.NoError(t, err)
+		go updater(P, conn, signErrCh)
 		go func(P *signing.LocalParty) {
 			if err := P.Start(); err != nil {
 				signErrCh <- err
@@ -196,19 +207,8 @@ signing:
 			return
 
 		case msg := <-signOutCh:
-			dest := msg.GetTo()
-			if dest == nil {
-				for _, P := range signParties {
-					if P.PartyID().Index == msg.GetFrom().Index {
-						continue
-					}
-					go updater(P, msg, signErrCh)
-				}
-			} else {
-				if dest[0].Index == msg.GetFrom().Index {
-					t.Fatalf("party %d tried to send a message to itself (%d)", dest[0].Index, msg.GetFrom().Index)
-				}
-				go updater(signParties[dest[0].Index], msg, signErrCh)
+			if err := signHub.Send(msg); err != nil {
+				assert.FailNow(t, err.Error())
 			}
 
 		case signData := <-signEndCh:
@@ -219,7 +219,7 @@ signing:
 				// BEGIN ECDSA verify
 				pkX, pkY := signKeys[0].ECDSAPub.X(), signKeys[0].ECDSAPub.Y()
 				pk := ecdsa.PublicKey{
-					Curve: tss.S256(),
+					Curve: curve,
 					X:     pkX,
 					Y:     pkY,
 				}
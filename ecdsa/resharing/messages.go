@@ -63,9 +63,14 @@ func NewDGRound1Message(
 
 func (m *DGRound1Message) ValidateBasic() bool {
 	return m != nil &&
-		common.NonEmptyBytes(m.EcdsaPubX) &&
-		common.NonEmptyBytes(m.EcdsaPubY) &&
-		common.NonEmptyBytes(m.VCommitment)
+		common.BoundedBytes(m.EcdsaPubX, tss.MaxScalarBytes) &&
+		common.BoundedBytes(m.EcdsaPubY, tss.MaxScalarBytes) &&
+		common.BoundedBytes(m.VCommitment, tss.MaxHashBytes) &&
+		len(m.Ssid) <= tss.MaxHashBytes
+}
+
+func (m *DGRound1Message) RoundNumber() int {
+	return 1
 }
 
 func (m *DGRound1Message) UnmarshalECDSAPub(ec elliptic.Curve) (*crypto.ECPoint, error) {
@@ -123,15 +128,20 @@ func NewDGRound2Message1(
 
 func (m *DGRound2Message1) ValidateBasic() bool {
 	return m != nil &&
-		// use with NoProofFac()
-		// common.NonEmptyMultiBytes(m.ModProof, modproof.ProofModBytesParts) &&
-		common.NonEmptyBytes(m.PaillierN) &&
-		common.NonEmptyBytes(m.NTilde) &&
-		common.NonEmptyBytes(m.H1) &&
-		common.NonEmptyBytes(m.H2) &&
+		// the proof is zero with NoProofMod()
+		len(m.ModProof) == modproof.ProofModBytesParts &&
+		common.MaxLenMultiBytes(m.ModProof, tss.MaxModulusBytes) &&
+		common.BoundedBytes(m.PaillierN, tss.MaxModulusBytes) &&
+		common.BoundedBytes(m.NTilde, tss.MaxModulusBytes) &&
+		common.BoundedBytes(m.H1, tss.MaxModulusBytes) &&
+		common.BoundedBytes(m.H2, tss.MaxModulusBytes) &&
 		// expected len of dln proof = sizeof(int64) + len(alpha) + len(t)
-		common.NonEmptyMultiBytes(m.GetDlnproof_1(), 2+(dlnproof.Iterations*2)) &&
-		common.NonEmptyMultiBytes(m.GetDlnproof_2(), 2+(dlnproof.Iterations*2))
+		common.BoundedMultiBytes(m.GetDlnproof_1(), tss.MaxModulusBytes, 2+(dlnproof.Iterations*2)) &&
+		common.BoundedMultiBytes(m.GetDlnproof_2(), tss.MaxModulusBytes, 2+(dlnproof.Iterations*2))
+}
+
+func (m *DGRound2Message1) RoundNumber() int {
+	return 2
 }
 
 func (m *DGRound2Message1) UnmarshalPaillierPK() *paillier.PublicKey {
@@ -185,6 +195,10 @@ func (m *DGRound2Message2) ValidateBasic() bool {
 	return true
 }
 
+func (m *DGRound2Message2) RoundNumber() int {
+	return 2
+}
+
 // ----- //
 
 func NewDGRound3Message1(
@@ -207,7 +221,11 @@ func NewDGRound3Message1(
 
 func (m *DGRound3Message1) ValidateBasic() bool {
 	return m != nil &&
-		common.NonEmptyBytes(m.Share)
+		common.BoundedBytes(m.Share, tss.MaxScalarBytes)
+}
+
+func (m *DGRound3Message1) RoundNumber() int {
+	return 3
 }
 
 // ----- //
@@ -233,7 +251,11 @@ func NewDGRound3Message2(
 
 func (m *DGRound3Message2) ValidateBasic() bool {
 	return m != nil &&
-		common.NonEmptyMultiBytes(m.VDecommitment)
+		common.BoundedMultiBytes(m.VDecommitment, tss.MaxScalarBytes)
+}
+
+func (m *DGRound3Message2) RoundNumber() int {
+	return 3
 }
 
 func (m *DGRound3Message2) UnmarshalVDeCommitment() cmt.HashDeCommitment {
@@ -262,6 +284,10 @@ func (m *DGRound4Message2) ValidateBasic() bool {
 	return true
 }
 
+func (m *DGRound4Message2) RoundNumber() int {
+	return 4
+}
+
 func NewDGRound4Message1(
 	to *tss.PartyID,
 	from *tss.PartyID,
@@ -282,9 +308,14 @@ func NewDGRound4Message1(
 }
 
 func (m *DGRound4Message1) ValidateBasic() bool {
-	return m != nil
-	// use with NoProofFac()
-	// && common.NonEmptyMultiBytes(m.GetFacProof(), facproof.ProofFacBytesParts)
+	return m != nil &&
+		// the proof is zero with NoProofFac()
+		len(m.GetFacProof()) == facproof.ProofFacBytesParts &&
+		common.MaxLenMultiBytes(m.GetFacProof(), tss.MaxProofPartBytes)
+}
+
+func (m *DGRound4Message1) RoundNumber() int {
+	return 4
 }
 
 func (m *DGRound4Message1) UnmarshalFacProof() (*facproof.ProofFac, error) {
@@ -9,8 +9,8 @@ package resharing
 import (
 	"errors"
 	"fmt"
-	"math/big"
 
+	"github.com/SafeMPC/tss-lib/common"
 	"github.com/SafeMPC/tss-lib/crypto"
 	"github.com/SafeMPC/tss-lib/crypto/commitments"
 	"github.com/SafeMPC/tss-lib/crypto/vss"
@@ -40,7 +40,7 @@ func (round *round1) Start() *tss.Error {
 	}
 	round.allOldOK()
 
-	round.temp.ssidNonce = new(big.Int).SetUint64(uint64(0))
+	round.temp.ssidNonce = round.SSIDNonce()
 	ssid, err := round.getSSID()
 	if err != nil {
 		return round.WrapError(err)
@@ -54,11 +54,13 @@ func (round *round1) Start() *tss.Error {
 	if round.Threshold()+1 > len(ks) {
 		return round.WrapError(fmt.Errorf("t+1=%d is not satisfied by the key count of %d", round.Threshold()+1, len(ks)), round.PartyID())
 	}
-	newKs := round.NewParties().IDs().Keys()
+	newKs := round.NewShareIDs()
 	wi, _ := signing.PrepareForSigning(round.Params().EC(), i, len(round.OldParties().IDs()), xi, ks, bigXj)
 
 	// 2.
 	vi, shares, err := vss.Create(round.Params().EC(), round.NewThreshold(), wi, newKs, round.Rand())
+	// w_i is needed only to deal the new shares
+	common.Zeroize(wi)
 	if err != nil {
 		return round.WrapError(err, round.PartyID())
 	}
@@ -110,19 +112,15 @@ func (round *round1) Update() (bool, *tss.Error) {
 		round.oldOK[j] = true
 
 		// save the ecdsa pub received from the old committee
-		if round.temp.dgRound1Messages[0] == nil {
-			ret = false
-			continue
-		}
-		r1msg := round.temp.dgRound1Messages[0].Content().(*DGRound1Message)
+		r1msg := msg.Content().(*DGRound1Message)
 		candidate, err := r1msg.UnmarshalECDSAPub(round.Params().EC())
 		if err != nil {
-			return false, round.WrapError(errors.New("unable to unmarshal the ecdsa pub key"), msg.GetFrom())
+			return false, round.WrapError(tss.Classify(errors.New("unable to unmarshal the ecdsa pub key"), tss.ErrBadMessage), msg.GetFrom()).WithMessages(msg)
 		}
 		if round.save.ECDSAPub != nil &&
 			!candidate.Equals(round.save.ECDSAPub) {
 			// uh oh - anomaly!
-			return false, round.WrapError(errors.New("ecdsa pub key did not match what we received previously"), msg.GetFrom())
+			return false, round.WrapError(errors.New("ecdsa pub key did not match what we received previously"), msg.GetFrom()).WithMessages(msg)
 		}
 		round.save.ECDSAPub = candidate
 	}
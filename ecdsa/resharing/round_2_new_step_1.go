@@ -8,11 +8,15 @@ package resharing
 
 import (
 	"bytes"
+	"context"
+	"crypto/rand"
 	"errors"
+	"fmt"
 	"math/big"
 
 	"github.com/SafeMPC/tss-lib/crypto/modproof"
 
+	"github.com/SafeMPC/tss-lib/common"
 	"github.com/SafeMPC/tss-lib/crypto/dlnproof"
 	"github.com/SafeMPC/tss-lib/ecdsa/keygen"
 	"github.com/SafeMPC/tss-lib/tss"
@@ -46,7 +50,8 @@ func (round *round2) Start() *tss.Error {
 		r1msg := round.temp.dgRound1Messages[j].Content().(*DGRound1Message)
 		SSIDj := r1msg.UnmarshalSSID()
 		if !bytes.Equal(SSID, SSIDj) {
-			return round.WrapError(errors.New("ssid mismatch"), Pj)
+			return round.WrapError(tss.Classify(errors.New("ssid mismatch"), tss.ErrBadMessage), Pj).
+				WithMessages(round.temp.dgRound1Messages[0], round.temp.dgRound1Messages[j])
 		}
 	}
 	round.temp.ssid = SSID
@@ -62,20 +67,34 @@ func (round *round2) Start() *tss.Error {
 	// generate safe primes for ZKPs later on
 	// compute ntilde, h1, h2 (uses safe primes)
 	// use the pre-params if they were provided to the LocalParty constructor
+	modulusBits := round.ModulusBits()
+	if modulusBits < tss.CurveModulusBits(round.EC()) {
+		return round.WrapError(tss.Classify(
+			fmt.Errorf("a modulus of %d bits is too small for the range proofs over this curve", modulusBits), tss.ErrInvalidParameters))
+	}
 	var preParams *keygen.LocalPreParams
 	if round.save.LocalPreParams.Validate() && !round.save.LocalPreParams.ValidateWithProof() {
 		return round.WrapError(
 			errors.New("`optionalPreParams` failed to validate; it might have been generated with an older version of tss-lib"))
 	} else if round.save.LocalPreParams.ValidateWithProof() {
+		if !round.save.LocalPreParams.HasModulusBits(modulusBits) {
+			return round.WrapError(tss.Classify(
+				fmt.Errorf("`optionalPreParams` do not have moduli of %d bits", modulusBits), tss.ErrInvalidParameters))
+		}
 		preParams = &round.save.LocalPreParams
 	} else {
+		ctx, cancel := context.WithTimeout(context.Background(), round.SafePrimeGenTimeout())
+		defer cancel()
+		// pre-parameters are background work that should not hold up the signings that share the worker pool
+		ctx = common.WithExecutor(ctx, round.Executor(tss.PriorityLow))
 		var err error
-		preParams, err = keygen.GeneratePreParams(round.SafePrimeGenTimeout(), round.Concurrency())
+		preParams, err = keygen.GeneratePreParamsWithModulusBits(ctx, rand.Reader, modulusBits, round.Concurrency())
 		if err != nil {
 			return round.WrapError(errors.New("pre-params generation failed"), Pi)
 		}
 	}
 	round.save.LocalPreParams = *preParams
+	round.save.ModulusBits = modulusBits
 	round.save.NTildej[i] = preParams.NTildei
 	round.save.H1j[i], round.save.H2j[i] = preParams.H1i, preParams.H2i
 
@@ -87,14 +106,19 @@ func (round *round2) Start() *tss.Error {
 		preParams.P,
 		preParams.Q,
 		preParams.NTildei
-	dlnProof1 := dlnproof.NewDLNProof(h1i, h2i, alpha, p, q, NTildei, round.Rand())
-	dlnProof2 := dlnproof.NewDLNProof(h2i, h1i, beta, p, q, NTildei, round.Rand())
+	var dlnProof1, dlnProof2 *dlnproof.Proof
+	common.RunOn(round.Executor(), func() {
+		dlnProof1 = dlnproof.NewDLNProof(h1i, h2i, alpha, p, q, NTildei, round.Rand())
+		dlnProof2 = dlnproof.NewDLNProof(h2i, h1i, beta, p, q, NTildei, round.Rand())
+	})
 
 	modProof := &modproof.ProofMod{W: zero, X: *new([80]*big.Int), A: zero, B: zero, Z: *new([80]*big.Int)}
 	ContextI := append(round.temp.ssid, big.NewInt(int64(i)).Bytes()...)
 	if !round.Parameters.NoProofMod() {
 		var err error
-		modProof, err = modproof.NewProof(ContextI, preParams.PaillierSK.N, preParams.PaillierSK.P, preParams.PaillierSK.Q, round.Rand())
+		common.RunOn(round.Executor(), func() {
+			modProof, err = modproof.NewProof(ContextI, preParams.PaillierSK.N, preParams.PaillierSK.P, preParams.PaillierSK.Q, round.Rand())
+		})
 		if err != nil {
 			return round.WrapError(err, Pi)
 		}
@@ -9,8 +9,10 @@ package resharing
 import (
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"math/big"
 	"sync"
+	"time"
 
 	"github.com/SafeMPC/tss-lib/crypto/facproof"
 
@@ -39,70 +41,77 @@ func (round *round4) Start() *tss.Error {
 		return nil
 	}
 
-	common.Logger.Debugf(
-		"%s Setting up DLN verification with concurrency level of %d",
-		round.PartyID(),
-		round.Concurrency(),
-	)
-	dlnVerifier := keygen.NewDlnProofVerifier(round.Concurrency())
+	round.logger().Debug("setting up DLN verification", "concurrency", round.Concurrency())
+	dlnVerifier := keygen.NewDlnProofVerifier(round.Concurrency(), round.Executor())
 
 	Pi := round.PartyID()
 	i := Pi.Index
 	round.newOK[i] = true
 
 	// 1-3. verify paillier & dln proofs, store message pieces, ensure uniqueness of h1j, h2j
+	modulusBits := round.ModulusBits()
 	h1H2Map := make(map[string]struct{}, len(round.temp.dgRound2Message1s)*2)
 	paiProofCulprits := make([]*tss.PartyID, len(round.temp.dgRound2Message1s)) // who caused the error(s)
 	dlnProof1FailCulprits := make([]*tss.PartyID, len(round.temp.dgRound2Message1s))
 	dlnProof2FailCulprits := make([]*tss.PartyID, len(round.temp.dgRound2Message1s))
 	wg := new(sync.WaitGroup)
+	exec := round.Executor()
 	for j, msg := range round.temp.dgRound2Message1s {
 		r2msg1 := msg.Content().(*DGRound2Message1)
 		paiPK, NTildej, H1j, H2j := r2msg1.UnmarshalPaillierPK(),
 			r2msg1.UnmarshalNTilde(),
 			r2msg1.UnmarshalH1(),
 			r2msg1.UnmarshalH2()
+		if paiPK.N.BitLen() != modulusBits {
+			return round.WrapError(tss.Classify(fmt.Errorf("got a paillier modulus of %d bits instead of %d from this party", paiPK.N.BitLen(), modulusBits), tss.ErrBadMessage), msg.GetFrom()).WithMessages(msg)
+		}
+		if NTildej.BitLen() != modulusBits {
+			return round.WrapError(tss.Classify(fmt.Errorf("got an NTildej of %d bits instead of %d from this party", NTildej.BitLen(), modulusBits), tss.ErrBadMessage), msg.GetFrom()).WithMessages(msg)
+		}
 		if H1j.Cmp(H2j) == 0 {
-			return round.WrapError(errors.New("h1j and h2j were equal for this party"), msg.GetFrom())
+			return round.WrapError(tss.Classify(errors.New("h1j and h2j were equal for this party"), tss.ErrBadMessage), msg.GetFrom()).WithMessages(msg)
 		}
 		h1JHex, h2JHex := hex.EncodeToString(H1j.Bytes()), hex.EncodeToString(H2j.Bytes())
 		if _, found := h1H2Map[h1JHex]; found {
-			return round.WrapError(errors.New("this h1j was already used by another party"), msg.GetFrom())
+			return round.WrapError(tss.Classify(errors.New("this h1j was already used by another party"), tss.ErrBadMessage), msg.GetFrom()).WithMessages(msg)
 		}
 		if _, found := h1H2Map[h2JHex]; found {
-			return round.WrapError(errors.New("this h2j was already used by another party"), msg.GetFrom())
+			return round.WrapError(tss.Classify(errors.New("this h2j was already used by another party"), tss.ErrBadMessage), msg.GetFrom()).WithMessages(msg)
 		}
 		h1H2Map[h1JHex], h1H2Map[h2JHex] = struct{}{}, struct{}{}
 		wg.Add(3)
-		go func(j int, msg tss.ParsedMessage, r2msg1 *DGRound2Message1) {
+		exec.Go(func() {
 			defer wg.Done()
 			modProof, err := r2msg1.UnmarshalModProof()
 			if err != nil {
 				if !round.Parameters.NoProofMod() {
 					paiProofCulprits[j] = msg.GetFrom()
 				}
-				common.Logger.Warningf("modProof verify failed for party %s", msg.GetFrom(), err)
+				round.logger().Warn("modProof verify failed", "from", msg.GetFrom().String(), "err", err)
 				return
 			}
 			ContextJ := common.AppendBigIntToBytesSlice(round.temp.ssid, big.NewInt(int64(j)))
-			if ok := modProof.Verify(ContextJ, paiPK.N); !ok {
+			if ok := round.verifyProof("mod", func() bool { return modProof.Verify(ContextJ, paiPK.N, exec) }); !ok {
 				paiProofCulprits[j] = msg.GetFrom()
-				common.Logger.Warningf("modProof verify failed for party %s", msg.GetFrom(), err)
+				round.logger().Warn("modProof verify failed", "from", msg.GetFrom().String())
 			}
-		}(j, msg, r2msg1)
+		})
 		_j := j
 		_msg := msg
+		start := time.Now()
 		dlnVerifier.VerifyDLNProof1(r2msg1, H1j, H2j, NTildej, func(isValid bool) {
+			round.ObserveProof(TaskName, round.number, "dln1", start, isValid)
 			if !isValid {
 				dlnProof1FailCulprits[_j] = _msg.GetFrom()
-				common.Logger.Warningf("dln proof 1 verify failed for party %s", _msg.GetFrom())
+				round.logger().Warn("dln proof 1 verify failed", "from", _msg.GetFrom().String())
 			}
 			wg.Done()
 		})
 		dlnVerifier.VerifyDLNProof2(r2msg1, H2j, H1j, NTildej, func(isValid bool) {
+			round.ObserveProof(TaskName, round.number, "dln2", start, isValid)
 			if !isValid {
 				dlnProof2FailCulprits[_j] = _msg.GetFrom()
-				common.Logger.Warningf("dln proof 2 verify failed for party %s", _msg.GetFrom())
+				round.logger().Warn("dln proof 2 verify failed", "from", _msg.GetFrom().String())
 			}
 			wg.Done()
 		})
@@ -110,7 +119,8 @@ func (round *round4) Start() *tss.Error {
 	wg.Wait()
 	for _, culprit := range append(append(paiProofCulprits, dlnProof1FailCulprits...), dlnProof2FailCulprits...) {
 		if culprit != nil {
-			return round.WrapError(errors.New("dln proof verification failed"), culprit)
+			return round.WrapError(tss.Classify(errors.New("dln proof verification failed"), tss.ErrInvalidProof), culprit).
+				WithMessages(round.temp.dgRound2Message1s[culprit.Index])
 		}
 	}
 	// save NTilde_j, h1_j, h2_j received in NewCommitteeStep1 here
@@ -142,11 +152,12 @@ func (round *round4) Start() *tss.Error {
 		ok, flatVs := vCmtDeCmt.DeCommit()
 		if !ok || len(flatVs) != (round.NewThreshold()+1)*2 { // they're points so * 2
 			// TODO collect culprits and return a list of them as per convention
-			return round.WrapError(errors.New("de-commitment of v_j0..v_jt failed"), round.Parties().IDs()[j])
+			return round.WrapError(tss.Classify(errors.New("de-commitment of v_j0..v_jt failed"), tss.ErrCommitmentMismatch), round.Parties().IDs()[j]).
+				WithMessages(round.temp.dgRound1Messages[j], round.temp.dgRound3Message2s[j])
 		}
 		vj, err := crypto.UnFlattenECPoints(round.Params().EC(), flatVs)
 		if err != nil {
-			return round.WrapError(err, round.Parties().IDs()[j])
+			return round.WrapError(err, round.Parties().IDs()[j]).WithMessages(round.temp.dgRound3Message2s[j])
 		}
 		vjc[j] = vj
 
@@ -154,12 +165,13 @@ func (round *round4) Start() *tss.Error {
 		r3msg1 := round.temp.dgRound3Message1s[j].Content().(*DGRound3Message1)
 		sharej := &vss.Share{
 			Threshold: round.NewThreshold(),
-			ID:        round.PartyID().KeyInt(),
+			ID:        round.NewShareIDs()[round.PartyID().Index],
 			Share:     new(big.Int).SetBytes(r3msg1.Share),
 		}
 		if ok := sharej.Verify(round.Params().EC(), round.NewThreshold(), vj); !ok {
 			// TODO collect culprits and return a list of them as per convention
-			return round.WrapError(errors.New("share from old committee did not pass Verify()"), round.Parties().IDs()[j])
+			return round.WrapError(tss.Classify(errors.New("share from old committee did not pass Verify()"), tss.ErrVSSFailure), round.Parties().IDs()[j]).
+				WithMessages(round.temp.dgRound3Message1s[j], round.temp.dgRound1Messages[j], round.temp.dgRound3Message2s[j])
 		}
 
 		// 9.
@@ -181,7 +193,7 @@ func (round *round4) Start() *tss.Error {
 
 	// 14.
 	if !Vc[0].Equals(round.save.ECDSAPub) {
-		return round.WrapError(errors.New("assertion failed: V_0 != y"), round.PartyID())
+		return round.WrapError(tss.Classify(errors.New("assertion failed: V_0 != y"), tss.ErrVSSFailure), round.PartyID())
 	}
 
 	// 15-19.
@@ -190,7 +202,7 @@ func (round *round4) Start() *tss.Error {
 	paiProofCulprits = make([]*tss.PartyID, 0, round.NewPartyCount()) // who caused the error(s)
 	for j := 0; j < round.NewPartyCount(); j++ {
 		Pj := round.NewParties().IDs()[j]
-		kj := Pj.KeyInt()
+		kj := round.NewShareIDs()[j]
 		newBigXj := Vc[0]
 		newKs = append(newKs, kj)
 		z := new(big.Int).SetInt64(int64(1))
@@ -222,8 +234,10 @@ func (round *round4) Start() *tss.Error {
 			Z1: zero, Z2: zero, W1: zero, W2: zero, V: zero,
 		}
 		if !round.Parameters.NoProofFac() {
-			facProof, err = facproof.NewProof(ContextJ, round.EC(), round.save.PaillierSK.N, round.save.NTildej[j],
-				round.save.H1j[j], round.save.H2j[j], round.save.PaillierSK.P, round.save.PaillierSK.Q, round.Rand())
+			common.RunOn(round.Executor(), func() {
+				facProof, err = facproof.NewProof(ContextJ, round.EC(), round.save.PaillierSK.N, round.save.NTildej[j],
+					round.save.H1j[j], round.save.H2j[j], round.save.PaillierSK.P, round.save.PaillierSK.Q, round.Rand())
+			})
 			if err != nil {
 				return round.WrapError(err, Pi)
 			}
@@ -10,7 +10,6 @@ import (
 	"errors"
 	"math/big"
 
-	"github.com/SafeMPC/tss-lib/common"
 	"github.com/SafeMPC/tss-lib/tss"
 )
 
@@ -32,7 +31,7 @@ func (round *round5) Start() *tss.Error {
 		// for this P: SAVE data
 		ContextI := append(round.temp.ssid, big.NewInt(int64(i)).Bytes()...)
 		round.save.BigXj = round.temp.newBigXjs
-		round.save.ShareID = round.PartyID().KeyInt()
+		round.save.ShareID = round.NewShareIDs()[i]
 		round.save.Xi = round.temp.newXi
 		round.save.Ks = round.temp.newKs
 
@@ -51,16 +50,18 @@ func (round *round5) Start() *tss.Error {
 			r4msg1 := msg.Content().(*DGRound4Message1)
 			proof, err := r4msg1.UnmarshalFacProof()
 			if err != nil && round.Parameters.NoProofFac() {
-				common.Logger.Warningf("facProof verify failed for party %s", msg.GetFrom(), err)
+				round.logger().Warn("facProof does not exist", "from", msg.GetFrom().String(), "err", err)
 			} else {
 				if err != nil {
-					common.Logger.Warningf("facProof verify failed for party %s", msg.GetFrom(), err)
-					return round.WrapError(err, round.NewParties().IDs()[j])
+					round.logger().Warn("facProof verify failed", "from", msg.GetFrom().String(), "err", err)
+					return round.WrapError(err, round.NewParties().IDs()[j]).WithMessages(msg)
 				}
-				if ok := proof.Verify(ContextI, round.EC(), round.save.PaillierPKs[j].N, round.save.NTildei,
-					round.save.H1i, round.save.H2i); !ok {
-					common.Logger.Warningf("facProof verify failed for party %s", msg.GetFrom(), err)
-					return round.WrapError(err, round.NewParties().IDs()[j])
+				if ok := round.verifyProof("fac", func() bool {
+					return proof.Verify(ContextI, round.EC(), round.save.PaillierPKs[j].N, round.save.NTildei,
+						round.save.H1i, round.save.H2i)
+				}); !ok {
+					round.logger().Warn("facProof verify failed", "from", msg.GetFrom().String())
+					return round.WrapError(tss.Classify(errors.New("facProof verify failed"), tss.ErrInvalidProof), round.NewParties().IDs()[j]).WithMessages(msg)
 				}
 			}
 
@@ -69,6 +70,9 @@ func (round *round5) Start() *tss.Error {
 		round.input.Xi.SetInt64(0)
 	}
 
+	// the new share is output in the save data
+	round.temp.newXi = nil
+	round.temp.zeroize()
 	round.end <- round.save
 	return nil
 }
@@ -84,3 +88,7 @@ func (round *round5) Update() (bool, *tss.Error) {
 func (round *round5) NextRound() tss.Round {
 	return nil // both committees are finished!
 }
+
+func (round *round5) IsFinal() bool {
+	return true
+}
@@ -9,6 +9,7 @@ package resharing
 import (
 	"errors"
 	"math/big"
+	"time"
 
 	"github.com/SafeMPC/tss-lib/common"
 	"github.com/SafeMPC/tss-lib/crypto"
@@ -113,6 +114,26 @@ func (round *base) WrapError(err error, culprits ...*tss.PartyID) *tss.Error {
 	return tss.NewError(err, TaskName, round.number, round.PartyID(), culprits...)
 }
 
+// State is captured in a snapshot of the party
+func (round *base) State() tss.RoundState {
+	return tss.RoundState{
+		Number:  round.number,
+		Started: round.started,
+		OK:      [][]bool{append([]bool{}, round.oldOK...), append([]bool{}, round.newOK...)},
+	}
+}
+
+// Restore puts a round created by NextRound() back into the state captured in a snapshot
+func (round *base) Restore(state tss.RoundState) error {
+	if len(state.OK) != 2 || len(state.OK[0]) != len(round.oldOK) || len(state.OK[1]) != len(round.newOK) {
+		return errors.New("the round state does not match the number of parties")
+	}
+	round.number, round.started = state.Number, state.Started
+	copy(round.oldOK, state.OK[0])
+	copy(round.newOK, state.OK[1])
+	return nil
+}
+
 // ----- //
 
 // `oldOK` tracks parties which have been verified by Update()
@@ -139,6 +160,19 @@ func (round *base) allNewOK() {
 	}
 }
 
+// logger returns the party's Logger enriched with the task and round
+func (round *base) logger() tss.Logger {
+	return round.Logger().With("task", TaskName, "round", round.number)
+}
+
+// verifyProof runs and times the verification of a proof, reporting it to the party's Observer
+func (round *base) verifyProof(proof string, verify func() bool) bool {
+	start := time.Now()
+	ok := verify()
+	round.ObserveProof(TaskName, round.number, proof, start, ok)
+	return ok
+}
+
 // get ssid from local params
 func (round *base) getSSID() ([]byte, error) {
 	ssidList := []*big.Int{round.EC().Params().P, round.EC().Params().N, round.EC().Params().B, round.EC().Params().Gx, round.EC().Params().Gy} // ec curve
@@ -147,10 +181,18 @@ func (round *base) getSSID() ([]byte, error) {
 	if err != nil {
 		return nil, round.WrapError(errors.New("read BigXj failed"), round.PartyID())
 	}
-	ssidList = append(ssidList, BigXjList...)                    // BigXj
-	ssidList = append(ssidList, round.input.NTildej...)          // NTilde
-	ssidList = append(ssidList, round.input.H1j...)              // h1
-	ssidList = append(ssidList, round.input.H2j...)              // h2
+	ssidList = append(ssidList, BigXjList...)           // BigXj
+	ssidList = append(ssidList, round.input.NTildej...) // NTilde
+	ssidList = append(ssidList, round.input.H1j...)     // h1
+	ssidList = append(ssidList, round.input.H2j...)     // h2
+	// the share IDs of either committee, if they are not the keys of its parties, so that the SSIDs of committees
+	// without share IDs remain those of older releases
+	if round.CustomShareIDs() {
+		ssidList = append(ssidList, round.ShareIDs()...)
+	}
+	if round.CustomNewShareIDs() {
+		ssidList = append(ssidList, round.NewShareIDs()...)
+	}
 	ssidList = append(ssidList, big.NewInt(int64(round.number))) // round number
 	ssidList = append(ssidList, round.temp.ssidNonce)
 	ssid := common.SHA512_256i(ssidList...).Bytes()
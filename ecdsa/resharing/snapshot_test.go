@@ -0,0 +1,148 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package resharing
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SafeMPC/tss-lib/crypto"
+	"github.com/SafeMPC/tss-lib/ecdsa/keygen"
+	"github.com/SafeMPC/tss-lib/test"
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+func TestSnapshotRestore(t *testing.T) {
+	threshold := test.TestThreshold
+	oldKeys, oldPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(threshold+1, test.TestParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	fixtures, _, err := keygen.LoadKeygenTestFixtures(test.TestParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	newPIDs := tss.GenerateTestPartyIDs(test.TestParticipants)
+	oldP2PCtx, newP2PCtx := tss.NewPeerContext(oldPIDs), tss.NewPeerContext(newPIDs)
+	snapshotKey := make([]byte, 32)
+	_, _ = rand.Read(snapshotKey)
+	newParams := func(pID *tss.PartyID) *tss.ReSharingParameters {
+		params := tss.NewReSharingParameters(tss.S256(), oldP2PCtx, newP2PCtx, pID, test.TestParticipants, threshold, len(newPIDs), threshold)
+		params.SetSnapshotKey(snapshotKey)
+		// do not use in untrusted setting
+		params.SetNoProofMod()
+		// do not use in untrusted setting
+		params.SetNoProofFac()
+		return params
+	}
+
+	bothCommitteesPax := len(oldPIDs) + len(newPIDs)
+	errCh := make(chan *tss.Error, bothCommitteesPax)
+	outCh := make(chan tss.Message, bothCommitteesPax*bothCommitteesPax*2)
+	endCh := make(chan *keygen.LocalPartySaveData, bothCommitteesPax)
+	oldCommittee := make([]*LocalParty, len(oldPIDs))
+	for j, pID := range oldPIDs {
+		oldCommittee[j] = NewLocalParty(newParams(pID), oldKeys[j], outCh, endCh).(*LocalParty)
+	}
+	newCommittee := make([]*LocalParty, len(newPIDs))
+	for j, pID := range newPIDs {
+		save := keygen.NewLocalPartySaveData(len(newPIDs))
+		save.LocalPreParams = fixtures[j].LocalPreParams
+		newCommittee[j] = NewLocalParty(newParams(pID), save, outCh, endCh).(*LocalParty)
+	}
+	for _, P := range append(append([]*LocalParty{}, newCommittee...), oldCommittee...) {
+		if err := P.Start(); err != nil {
+			assert.FailNow(t, err.Error())
+		}
+	}
+
+	// deliver routes a message to its committees as the transport would
+	deliver := func(msg tss.Message) {
+		var committees [][]*LocalParty
+		switch {
+		case msg.IsToOldAndNewCommittees():
+			committees = [][]*LocalParty{oldCommittee, newCommittee}
+		case msg.IsToOldCommittee():
+			committees = [][]*LocalParty{oldCommittee}
+		default:
+			committees = [][]*LocalParty{newCommittee}
+		}
+		for _, committee := range committees {
+			for _, P := range committee {
+				if msg.GetTo() == nil {
+					test.SharedPartyUpdater(P, msg, errCh)
+					continue
+				}
+				for _, to := range msg.GetTo() {
+					if to.KeyInt().Cmp(P.PartyID().KeyInt()) == 0 {
+						test.SharedPartyUpdater(P, msg, errCh)
+					}
+				}
+			}
+		}
+	}
+
+	restoredOld, restoredNew := false, false
+	newKeys := make([]*keygen.LocalPartySaveData, 0, len(newPIDs))
+	for ended := 0; ended < bothCommitteesPax; {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case save := <-endCh:
+			if save.Xi != nil {
+				newKeys = append(newKeys, save)
+			}
+			ended++
+		case msg := <-outCh:
+			deliver(msg)
+			if !restoredOld && oldCommittee[0].temp.dgRound2Message2s[1] != nil {
+				// restart old party 0 after it has dealt the new shares
+				snapshot, err := oldCommittee[0].Snapshot()
+				if !assert.NoError(t, err) {
+					return
+				}
+				shares := oldCommittee[0].temp.NewShares
+				P, err := RestoreLocalParty(newParams(oldPIDs[0]), snapshot, outCh, endCh)
+				if !assert.NoError(t, err) {
+					return
+				}
+				oldCommittee[0] = P.(*LocalParty)
+				if assert.Len(t, oldCommittee[0].temp.NewShares, len(shares)) {
+					for j, share := range shares {
+						assert.Equal(t, 0, share.Share.Cmp(oldCommittee[0].temp.NewShares[j].Share), "the restored party must keep its shares")
+					}
+				}
+				assert.NotNil(t, oldCommittee[0].Start(), "a restored party must not start again")
+				restoredOld = true
+			}
+			if !restoredNew && newCommittee[0].temp.dgRound3Message1s[1] != nil {
+				// restart new party 0 while it is receiving its new share
+				snapshot, err := newCommittee[0].Snapshot()
+				if !assert.NoError(t, err) {
+					return
+				}
+				paillierSK := newCommittee[0].save.PaillierSK
+				P, err := RestoreLocalParty(newParams(newPIDs[0]), snapshot, outCh, endCh)
+				if !assert.NoError(t, err) {
+					return
+				}
+				newCommittee[0] = P.(*LocalParty)
+				assert.Equal(t, 0, paillierSK.LambdaN.Cmp(newCommittee[0].save.PaillierSK.LambdaN), "the restored party must keep its pre-parameters")
+				assert.NotNil(t, newCommittee[0].Start(), "a restored party must not start again")
+				restoredNew = true
+			}
+		}
+	}
+	assert.True(t, restoredOld)
+	assert.True(t, restoredNew)
+	if assert.Len(t, newKeys, len(newPIDs)) {
+		for _, key := range newKeys {
+			assert.True(t, key.ECDSAPub.Equals(oldKeys[0].ECDSAPub))
+			index, err := key.OriginalIndex()
+			assert.NoError(t, err)
+			assert.True(t, key.BigXj[index].Equals(crypto.ScalarBaseMult(tss.S256(), key.Xi)), "ensure BigX_j == g^x_j")
+		}
+	}
+}
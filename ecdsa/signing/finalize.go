@@ -25,15 +25,21 @@ func (round *finalization) Start() *tss.Error {
 	round.resetOK()
 
 	sumS := round.temp.si
-	modN := common.ModInt(round.Params().EC().Params().N)
+	N := round.Params().EC().Params().N
+	modN := common.ModInt(N)
 
-	for j := range round.Parties().IDs() {
+	for j, Pj := range round.Parties().IDs() {
 		round.ok[j] = true
 		if j == round.PartyID().Index {
 			continue
 		}
 		r9msg := round.temp.signRound9Messages[j].Content().(*SignRound9Message)
-		sumS = modN.Add(sumS, r9msg.UnmarshalS())
+		sj := r9msg.UnmarshalS()
+		if !common.IsInInterval(sj, N) {
+			return round.WrapError(tss.Classify(errors.New("s is not less than the curve order"), tss.ErrBadMessage), Pj).
+				WithMessages(round.temp.signRound9Messages[j])
+		}
+		sumS = modN.Add(sumS, sj)
 	}
 
 	recid := 0
@@ -80,6 +86,7 @@ func (round *finalization) Start() *tss.Error {
 		return round.WrapError(fmt.Errorf("signature verification failed"))
 	}
 
+	round.temp.zeroize()
 	round.end <- round.data
 
 	return nil
@@ -99,6 +106,10 @@ func (round *finalization) NextRound() tss.Round {
 	return nil // finished!
 }
 
+func (round *finalization) IsFinal() bool {
+	return true
+}
+
 func padToLengthBytesInPlace(src []byte, length int) []byte {
 	oriLen := len(src)
 	if oriLen < length {
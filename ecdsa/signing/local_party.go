@@ -122,9 +122,9 @@ func NewLocalPartyWithKDD(
 ) tss.Party {
 	partyCount := len(params.Parties().IDs())
 	p := &LocalParty{
-		BaseParty: new(tss.BaseParty),
+		BaseParty: tss.NewBaseParty(out),
 		params:    params,
-		keys:      keygen.BuildLocalSaveDataSubset(key, params.Parties().IDs()),
+		keys:      keygen.BuildLocalSaveDataSubset(key, params.Parties().IDs(), params.ShareIDs()...),
 		temp:      localTempData{},
 		data:      &common.SignatureData{},
 		out:       out,
@@ -181,10 +181,18 @@ func (p *LocalParty) Update(msg tss.ParsedMessage) (ok bool, err *tss.Error) {
 	return tss.BaseUpdate(p, msg, TaskName)
 }
 
+// Abort stops the party, overwrites the secrets in its temp data and drops the messages that it received
+func (p *LocalParty) Abort(reason error) *tss.Error {
+	return tss.BaseAbort(p, TaskName, reason, func() {
+		p.temp.zeroize()
+		p.temp.localMessageStore = localMessageStore{}
+	})
+}
+
 func (p *LocalParty) UpdateFromBytes(wireBytes []byte, from *tss.PartyID, isBroadcast bool) (bool, *tss.Error) {
-	msg, err := tss.ParseWireMessage(wireBytes, from, isBroadcast)
+	msg, err := p.params.ParseWireMessage(wireBytes, from, isBroadcast)
 	if err != nil {
-		return false, p.WrapError(err)
+		return false, tss.WrapParseError(p, err, wireBytes, from, isBroadcast)
 	}
 	return p.Update(msg)
 }
@@ -195,8 +203,8 @@ func (p *LocalParty) ValidateMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	}
 	// check that the message's "from index" will fit into the array
 	if maxFromIdx := len(p.params.Parties().IDs()) - 1; maxFromIdx < msg.GetFrom().Index {
-		return false, p.WrapError(fmt.Errorf("received msg with a sender index too great (%d <= %d)",
-			maxFromIdx, msg.GetFrom().Index), msg.GetFrom())
+		return false, p.WrapError(tss.Classify(fmt.Errorf("received msg with a sender index too great (%d <= %d)",
+			maxFromIdx, msg.GetFrom().Index), tss.ErrBadMessage), msg.GetFrom()).WithMessages(msg)
 	}
 	return true, nil
 }
@@ -209,33 +217,34 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	fromPIdx := msg.GetFrom().Index
 
 	// switch/case is necessary to store any messages beyond current round
-	// this does not handle message replays. we expect the caller to apply replay and spoofing protection.
+	// a repeated message from the same sender is ignored if identical and rejected as equivocation otherwise.
+	var slot *tss.ParsedMessage
 	switch msg.Content().(type) {
 	case *SignRound1Message1:
-		p.temp.signRound1Message1s[fromPIdx] = msg
+		slot = &p.temp.signRound1Message1s[fromPIdx]
 	case *SignRound1Message2:
-		p.temp.signRound1Message2s[fromPIdx] = msg
+		slot = &p.temp.signRound1Message2s[fromPIdx]
 	case *SignRound2Message:
-		p.temp.signRound2Messages[fromPIdx] = msg
+		slot = &p.temp.signRound2Messages[fromPIdx]
 	case *SignRound3Message:
-		p.temp.signRound3Messages[fromPIdx] = msg
+		slot = &p.temp.signRound3Messages[fromPIdx]
 	case *SignRound4Message:
-		p.temp.signRound4Messages[fromPIdx] = msg
+		slot = &p.temp.signRound4Messages[fromPIdx]
 	case *SignRound5Message:
-		p.temp.signRound5Messages[fromPIdx] = msg
+		slot = &p.temp.signRound5Messages[fromPIdx]
 	case *SignRound6Message:
-		p.temp.signRound6Messages[fromPIdx] = msg
+		slot = &p.temp.signRound6Messages[fromPIdx]
 	case *SignRound7Message:
-		p.temp.signRound7Messages[fromPIdx] = msg
+		slot = &p.temp.signRound7Messages[fromPIdx]
 	case *SignRound8Message:
-		p.temp.signRound8Messages[fromPIdx] = msg
+		slot = &p.temp.signRound8Messages[fromPIdx]
 	case *SignRound9Message:
-		p.temp.signRound9Messages[fromPIdx] = msg
+		slot = &p.temp.signRound9Messages[fromPIdx]
 	default: // unrecognised message, just ignore!
-		common.Logger.Warningf("unrecognised message ignored: %v", msg)
+		p.params.Logger().Warn("unrecognised message ignored", "task", TaskName, "msg", msg.String())
 		return false, nil
 	}
-	return true, nil
+	return tss.StoreMessageOnce(p, slot, msg)
 }
 
 func (p *LocalParty) PartyID() *tss.PartyID {
@@ -245,3 +254,10 @@ func (p *LocalParty) PartyID() *tss.PartyID {
 func (p *LocalParty) String() string {
 	return fmt.Sprintf("id: %s, %s", p.PartyID(), p.BaseParty.String())
 }
+
+// zeroize overwrites the secrets in the temp data; the party does so itself once it has output its result
+func (temp *localTempData) zeroize() {
+	common.Zeroize(temp.w, temp.k, temp.gamma, temp.sigma, temp.li, temp.roi)
+	common.Zeroize(temp.betas...)
+	common.Zeroize(temp.vs...)
+}
@@ -7,14 +7,20 @@
 package signing
 
 import (
+	"context"
 	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math/big"
+	"os"
+	"path/filepath"
 	"runtime"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/ipfs/go-log"
@@ -24,6 +30,7 @@ import (
 	"github.com/SafeMPC/tss-lib/ecdsa/keygen"
 	"github.com/SafeMPC/tss-lib/test"
 	"github.com/SafeMPC/tss-lib/tss"
+	"github.com/SafeMPC/tss-lib/tss/transport"
 )
 
 const (
@@ -38,11 +45,29 @@ func setUp(level string) {
 }
 
 func TestE2EConcurrent(t *testing.T) {
+	testE2EConcurrent(t, tss.S256(), nil)
+}
+
+func TestE2EConcurrentP256(t *testing.T) {
+	testE2EConcurrent(t, tss.P256(), nil)
+}
+
+func TestE2EConcurrentWorkerPool(t *testing.T) {
+	// every party shares a pool with fewer workers than there are MtA proofs in a round
+	pool := tss.NewWorkerPool(2)
+	defer pool.Close()
+	testE2EConcurrent(t, tss.S256(), func(params *tss.Parameters) {
+		params.SetWorkerPool(pool)
+		params.SetPriority(tss.PriorityHigh)
+	})
+}
+
+func testE2EConcurrent(t *testing.T, curve elliptic.Curve, configure func(*tss.Parameters)) {
 	setUp("info")
 	threshold := testThreshold
 
 	// PHASE: load keygen fixtures
-	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSetForCurve(curve, testThreshold+1, testParticipants)
 	assert.NoError(t, err, "should load keygen fixtures")
 	assert.Equal(t, testThreshold+1, len(keys))
 	assert.Equal(t, testThreshold+1, len(signPIDs))
@@ -56,12 +81,20 @@ func TestE2EConcurrent(t *testing.T) {
 	outCh := make(chan tss.Message, len(signPIDs))
 	endCh := make(chan *common.SignatureData, len(signPIDs))
 
-	updater := test.SharedPartyUpdater
+	hub := transport.NewHub()
+	defer hub.Close()
+	updater := test.SharedTransportUpdater
 	// init the parties
 	for i := 0; i < len(signPIDs); i++ {
-		params := tss.NewParameters(tss.S256(), p2pCtx, signPIDs[i], len(signPIDs), threshold)
+		params := tss.NewParameters(curve, p2pCtx, signPIDs[i], len(signPIDs), threshold)
+		if configure != nil {
+			configure(params)
+		}
 		P := NewLocalParty(big.NewInt(42), params, keys[i], outCh, endCh).(*LocalParty)
 		parties = append(parties, P)
+		conn, err := hub.Connect(P.PartyID())
+		assert.NoError(t, err)
+		go updater(P, conn, errCh)
 		go func(P *LocalParty) {
 			if err := P.Start(); err != nil {
 				errCh <- err
@@ -80,19 +113,8 @@ signing:
 			break signing
 
 		case msg := <-outCh:
-			dest := msg.GetTo()
-			if dest == nil {
-				for _, P := range parties {
-					if P.PartyID().Index == msg.GetFrom().Index {
-						continue
-					}
-					go updater(P, msg, errCh)
-				}
-			} else {
-				if dest[0].Index == msg.GetFrom().Index {
-					t.Fatalf("party %d tried to send a message to itself (%d)", dest[0].Index, msg.GetFrom().Index)
-				}
-				go updater(parties[dest[0].Index], msg, errCh)
+			if err := hub.Send(msg); err != nil {
+				assert.FailNow(t, err.Error())
 			}
 
 		case <-endCh:
@@ -103,7 +125,7 @@ signing:
 				r := parties[0].temp.rx
 				fmt.Printf("sign result: R(%s, %s), r=%s\n", R.X().String(), R.Y().String(), r.String())
 
-				modN := common.ModInt(tss.S256().Params().N)
+				modN := common.ModInt(curve.Params().N)
 
 				// BEGIN check s correctness
 				sumS := big.NewInt(0)
@@ -116,7 +138,7 @@ signing:
 				// BEGIN ECDSA verify
 				pkX, pkY := keys[0].ECDSAPub.X(), keys[0].ECDSAPub.Y()
 				pk := ecdsa.PublicKey{
-					Curve: tss.EC(),
+					Curve: curve,
 					X:     pkX,
 					Y:     pkY,
 				}
@@ -150,13 +172,18 @@ func TestE2EConcurrentWithLeadingZeroInMSG(t *testing.T) {
 	outCh := make(chan tss.Message, len(signPIDs))
 	endCh := make(chan *common.SignatureData, len(signPIDs))
 
-	updater := test.SharedPartyUpdater
+	hub := transport.NewHub()
+	defer hub.Close()
+	updater := test.SharedTransportUpdater
 	msgData, _ := hex.DecodeString("00f163ee51bcaeff9cdff5e0e3c1a646abd19885fffbab0b3b4236e0cf95c9f5")
 	// init the parties
 	for i := 0; i < len(signPIDs); i++ {
 		params := tss.NewParameters(tss.S256(), p2pCtx, signPIDs[i], len(signPIDs), threshold)
 		P := NewLocalParty(new(big.Int).SetBytes(msgData), params, keys[i], outCh, endCh, len(msgData)).(*LocalParty)
 		parties = append(parties, P)
+		conn, err := hub.Connect(P.PartyID())
+		assert.NoError(t, err)
+		go updater(P, conn, errCh)
 		go func(P *LocalParty) {
 			if err := P.Start(); err != nil {
 				errCh <- err
@@ -175,19 +202,8 @@ signing:
 			break signing
 
 		case msg := <-outCh:
-			dest := msg.GetTo()
-			if dest == nil {
-				for _, P := range parties {
-					if P.PartyID().Index == msg.GetFrom().Index {
-						continue
-					}
-					go updater(P, msg, errCh)
-				}
-			} else {
-				if dest[0].Index == msg.GetFrom().Index {
-					t.Fatalf("party %d tried to send a message to itself (%d)", dest[0].Index, msg.GetFrom().Index)
-				}
-				go updater(parties[dest[0].Index], msg, errCh)
+			if err := hub.Send(msg); err != nil {
+				assert.FailNow(t, err.Error())
 			}
 
 		case <-endCh:
@@ -211,7 +227,7 @@ signing:
 				// BEGIN ECDSA verify
 				pkX, pkY := keys[0].ECDSAPub.X(), keys[0].ECDSAPub.Y()
 				pk := ecdsa.PublicKey{
-					Curve: tss.EC(),
+					Curve: tss.S256(),
 					X:     pkX,
 					Y:     pkY,
 				}
@@ -258,7 +274,9 @@ func TestE2EWithHDKeyDerivation(t *testing.T) {
 	outCh := make(chan tss.Message, len(signPIDs))
 	endCh := make(chan *common.SignatureData, len(signPIDs))
 
-	updater := test.SharedPartyUpdater
+	hub := transport.NewHub()
+	defer hub.Close()
+	updater := test.SharedTransportUpdater
 
 	// init the parties
 	for i := 0; i < len(signPIDs); i++ {
@@ -266,6 +284,9 @@ func TestE2EWithHDKeyDerivation(t *testing.T) {
 
 		P := NewLocalPartyWithKDD(big.NewInt(42), params, keys[i], keyDerivationDelta, outCh, endCh, 0).(*LocalParty)
 		parties = append(parties, P)
+		conn, err := hub.Connect(P.PartyID())
+		assert.NoError(t, err)
+		go updater(P, conn, errCh)
 		go func(P *LocalParty) {
 			if err := P.Start(); err != nil {
 				errCh <- err
@@ -283,19 +304,8 @@ signing:
 			break signing
 
 		case msg := <-outCh:
-			dest := msg.GetTo()
-			if dest == nil {
-				for _, P := range parties {
-					if P.PartyID().Index == msg.GetFrom().Index {
-						continue
-					}
-					go updater(P, msg, errCh)
-				}
-			} else {
-				if dest[0].Index == msg.GetFrom().Index {
-					t.Fatalf("party %d tried to send a message to itself (%d)", dest[0].Index, msg.GetFrom().Index)
-				}
-				go updater(parties[dest[0].Index], msg, errCh)
+			if err := hub.Send(msg); err != nil {
+				assert.FailNow(t, err.Error())
 			}
 
 		case <-endCh:
@@ -319,7 +329,7 @@ signing:
 				// BEGIN ECDSA verify
 				pkX, pkY := keys[0].ECDSAPub.X(), keys[0].ECDSAPub.Y()
 				pk := ecdsa.PublicKey{
-					Curve: tss.EC(),
+					Curve: tss.S256(),
 					X:     pkX,
 					Y:     pkY,
 				}
@@ -334,6 +344,310 @@ signing:
 	}
 }
 
+// TestE2EWithRotatedIdentities signs with parties whose transport identities changed after keygen; their shares remain
+// at the share IDs that they were dealt at, which are given as the parties' share IDs
+func TestE2EWithRotatedIdentities(t *testing.T) {
+	keys, oldPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	signPIDs := tss.GenerateTestPartyIDs(len(oldPIDs))
+	for _, pID := range signPIDs {
+		for _, oldPID := range oldPIDs {
+			assert.NotEqual(t, 0, pID.KeyInt().Cmp(oldPID.KeyInt()), "the identities must have changed")
+		}
+	}
+	shareIDs := oldPIDs.Keys()
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	hub := transport.NewHub()
+	defer hub.Close()
+
+	errCh := make(chan *tss.Error, len(signPIDs))
+	sigCh := make(chan *common.SignatureData, len(signPIDs))
+	for i, pID := range signPIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(signPIDs), testThreshold)
+		params.SetShareIDs(shareIDs)
+		conn, err := hub.Connect(pID)
+		assert.NoError(t, err)
+		outCh := make(chan tss.Message, 2*len(signPIDs))
+		endCh := make(chan *common.SignatureData, 1)
+		P := NewLocalParty(big.NewInt(42), params, keys[i], outCh, endCh)
+		go func() {
+			sig, err := tss.Run(ctx, P, conn, outCh, endCh)
+			errCh <- err
+			sigCh <- sig
+		}()
+	}
+	pk := ecdsa.PublicKey{Curve: tss.S256(), X: keys[0].ECDSAPub.X(), Y: keys[0].ECDSAPub.Y()}
+	for range signPIDs {
+		if err := <-errCh; err != nil {
+			t.Fatal(err)
+		}
+		sig := <-sigCh
+		r, sumS := new(big.Int).SetBytes(sig.GetR()), new(big.Int).SetBytes(sig.GetS())
+		assert.True(t, ecdsa.Verify(&pk, big.NewInt(42).Bytes(), r, sumS), "ecdsa verify must pass")
+	}
+}
+
+// TestE2EWith3072BitModuli runs a keygen with 3072-bit moduli and signs with the key, so that the messages of both
+// stay within the wire size limits and signing validates the peers against the recorded size. Generating the
+// pre-parameters takes minutes, so the test runs only if TSS_SLOW_TESTS is set.
+func TestE2EWith3072BitModuli(t *testing.T) {
+	if os.Getenv("TSS_SLOW_TESTS") == "" {
+		t.Skip("set TSS_SLOW_TESTS to run the test with 3072-bit moduli")
+	}
+	const modulusBits = 3072
+	pIDs := tss.GenerateTestPartyIDs(testThreshold + 1)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Minute)
+	defer cancel()
+
+	preParams := make([]*keygen.LocalPreParams, len(pIDs))
+	for i := range preParams {
+		var err error
+		if preParams[i], err = keygen.GeneratePreParamsWithModulusBits(ctx, rand.Reader, modulusBits); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	hub := transport.NewHub()
+	defer hub.Close()
+	errCh := make(chan *tss.Error, len(pIDs))
+	saveCh := make(chan *keygen.LocalPartySaveData, len(pIDs))
+	for i, pID := range pIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(pIDs), testThreshold)
+		params.SetModulusBits(modulusBits)
+		conn, err := hub.Connect(pID)
+		assert.NoError(t, err)
+		outCh := make(chan tss.Message, 2*len(pIDs))
+		endCh := make(chan *keygen.LocalPartySaveData, 1)
+		P := keygen.NewLocalParty(params, outCh, endCh, *preParams[i])
+		go func() {
+			save, err := tss.Run(ctx, P, conn, outCh, endCh)
+			errCh <- err
+			saveCh <- save
+		}()
+	}
+	keys := make([]keygen.LocalPartySaveData, len(pIDs))
+	for range pIDs {
+		if err := <-errCh; err != nil {
+			t.Fatal(err)
+		}
+		save := <-saveCh
+		assert.Equal(t, modulusBits, save.ModulusBitLen())
+		j, err := save.ValidateModuli()
+		assert.Equal(t, -1, j)
+		assert.NoError(t, err)
+		index, err := save.OriginalIndex()
+		if !assert.NoError(t, err) {
+			return
+		}
+		keys[index] = *save
+	}
+
+	// sign on a new hub, so that the messages of keygen are not delivered to the signers
+	signHub := transport.NewHub()
+	defer signHub.Close()
+	sigCh := make(chan *common.SignatureData, len(pIDs))
+	for i, pID := range pIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(pIDs), testThreshold)
+		conn, err := signHub.Connect(pID)
+		assert.NoError(t, err)
+		outCh := make(chan tss.Message, 2*len(pIDs))
+		endCh := make(chan *common.SignatureData, 1)
+		P := NewLocalParty(big.NewInt(42), params, keys[i], outCh, endCh)
+		go func() {
+			sig, err := tss.Run(ctx, P, conn, outCh, endCh)
+			errCh <- err
+			sigCh <- sig
+		}()
+	}
+	pk := ecdsa.PublicKey{Curve: tss.S256(), X: keys[0].ECDSAPub.X(), Y: keys[0].ECDSAPub.Y()}
+	for range pIDs {
+		if err := <-errCh; err != nil {
+			t.Fatal(err)
+		}
+		sig := <-sigCh
+		r, sumS := new(big.Int).SetBytes(sig.GetR()), new(big.Int).SetBytes(sig.GetS())
+		assert.True(t, ecdsa.Verify(&pk, big.NewInt(42).Bytes(), r, sumS), "ecdsa verify must pass")
+	}
+}
+
+func TestAbort(t *testing.T) {
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	params := tss.NewParameters(tss.S256(), p2pCtx, signPIDs[0], len(signPIDs), testThreshold)
+	outCh := make(chan tss.Message, 2*len(signPIDs))
+	P := NewLocalParty(big.NewInt(42), params, keys[0], outCh, make(chan *common.SignatureData, 1)).(*LocalParty)
+	if !assert.Nil(t, P.Start()) {
+		return
+	}
+	w, k, gamma := P.temp.w, P.temp.k, P.temp.gamma
+	assert.NotZero(t, w.Sign())
+	assert.NotZero(t, k.Sign())
+	assert.NotZero(t, gamma.Sign())
+
+	culprit := signPIDs[1]
+	err = P.Abort(P.WrapError(errors.New("the peer went away"), culprit))
+	if assert.NotNil(t, err) {
+		assert.ErrorIs(t, err, tss.ErrAborted)
+		assert.Equal(t, tss.CodeAborted, err.(*tss.Error).Code())
+		assert.Equal(t, []*tss.PartyID{culprit}, err.(*tss.Error).Culprits())
+	}
+	assert.Zero(t, w.Sign(), "w must be zeroized")
+	assert.Zero(t, k.Sign(), "k must be zeroized")
+	assert.Zero(t, gamma.Sign(), "gamma must be zeroized")
+	assert.NotZero(t, keys[0].Xi.Sign(), "the key must not be zeroized")
+	assert.False(t, P.Running())
+	assert.Nil(t, P.temp.signRound1Message1s)
+
+	// the party rejects every message afterwards
+	_, tErr := P.Update(NewSignRound1Message2(culprit, big.NewInt(1)))
+	assert.Equal(t, err, tErr)
+	assert.Equal(t, err, P.Abort(errors.New("again")), "aborting again returns the same error")
+	assert.NotNil(t, P.Start())
+}
+
+const transcriptFixtureFile = "%s/../../test/_ecdsa_fixtures/signing_transcript_v%d.json"
+
+// TestReplayTranscript replays a saved transcript of the first signer so that a change to the computation of a party
+// is caught. The transcript is recorded and saved when it does not exist yet.
+func TestReplayTranscript(t *testing.T) {
+	keys, signPIDs, transcript := loadSigningTranscript(t)
+	if transcript == nil {
+		return
+	}
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	params := tss.NewParameters(tss.S256(), p2pCtx, signPIDs[0], len(signPIDs), testThreshold)
+	outCh := make(chan tss.Message, 2*len(signPIDs))
+	endCh := make(chan *common.SignatureData, 1)
+	replay, err := tss.NewReplay(transcript, NewLocalParty(big.NewInt(42), params, keys[0], outCh, endCh), outCh)
+	if !assert.NoError(t, err) {
+		return
+	}
+	report := replay.Run()
+	assert.Nil(t, report.Divergence)
+	assert.Nil(t, report.Err)
+	assert.Equal(t, len(transcript.Inbound)+1, report.Steps)
+
+	pk := ecdsa.PublicKey{Curve: tss.S256(), X: keys[0].ECDSAPub.X(), Y: keys[0].ECDSAPub.Y()}
+	sig := <-endCh
+	r, sumS := new(big.Int).SetBytes(sig.GetR()), new(big.Int).SetBytes(sig.GetS())
+	assert.True(t, ecdsa.Verify(&pk, big.NewInt(42).Bytes(), r, sumS), "ecdsa verify must pass")
+}
+
+// TestReplayFinalizeFailure replays the saved transcript with a bad s_j from a peer, as a transcript of a signing
+// that failed in finalize would hold, and checks that the replay reproduces the failure
+func TestReplayFinalizeFailure(t *testing.T) {
+	keys, signPIDs, transcript := loadSigningTranscript(t)
+	if transcript == nil {
+		return
+	}
+	tampered := false
+	for i, msg := range transcript.Inbound {
+		parsed, err := tss.ParseWireMessage(msg.WireBytes, msg.From, msg.IsBroadcast)
+		if !assert.NoError(t, err) {
+			return
+		}
+		r9msg, ok := parsed.Content().(*SignRound9Message)
+		if !ok || tampered {
+			continue
+		}
+		sj := new(big.Int).Add(new(big.Int).SetBytes(r9msg.GetS()), big.NewInt(1))
+		sj.Mod(sj, tss.S256().Params().N)
+		bz, _, err := NewSignRound9Message(msg.From, sj).WireBytes()
+		if !assert.NoError(t, err) {
+			return
+		}
+		transcript.Inbound[i].WireBytes = bz
+		tampered = true
+	}
+	if !assert.True(t, tampered, "the transcript must hold an s_j") {
+		return
+	}
+
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	params := tss.NewParameters(tss.S256(), p2pCtx, signPIDs[0], len(signPIDs), testThreshold)
+	outCh := make(chan tss.Message, 2*len(signPIDs))
+	replay, err := tss.NewReplay(transcript, NewLocalParty(big.NewInt(42), params, keys[0], outCh, make(chan *common.SignatureData, 1)), outCh)
+	if !assert.NoError(t, err) {
+		return
+	}
+	// the party computes what it did until the bad s_j arrives, and then fails in finalize
+	report := replay.Run()
+	if assert.NotNil(t, report.Err) {
+		assert.Contains(t, report.Err.Error(), "signature verification failed")
+	}
+	if assert.NotNil(t, report.Divergence) {
+		assert.Equal(t, len(transcript.Inbound), report.Divergence.Step)
+		assert.Contains(t, report.Divergence.Reason, "signature verification failed")
+	}
+}
+
+// loadSigningTranscript loads the saved transcript of the first of the first testThreshold+1 signers, recording and
+// saving it when it does not exist yet. The transcript is nil if it could not be loaded.
+func loadSigningTranscript(t *testing.T) ([]keygen.LocalPartySaveData, tss.SortedPartyIDs, *tss.Transcript) {
+	keys, signPIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 1)
+	if !assert.NoError(t, err, "should load keygen fixtures") {
+		return nil, nil, nil
+	}
+	_, callerFileName, _, _ := runtime.Caller(0)
+	fixtureFileName := fmt.Sprintf(transcriptFixtureFile, filepath.Dir(callerFileName), tss.TranscriptVersion)
+	bz, err := os.ReadFile(fixtureFileName)
+	if os.IsNotExist(err) {
+		bz = recordSigning(t, keys, signPIDs, big.NewInt(42))
+		assert.NoError(t, os.WriteFile(fixtureFileName, bz, 0600))
+		t.Logf("Saved a signing transcript: %s", fixtureFileName)
+	} else if !assert.NoError(t, err) {
+		return nil, nil, nil
+	}
+	transcript, err := tss.UnmarshalTranscript(bz)
+	if !assert.NoError(t, err) {
+		return nil, nil, nil
+	}
+	return keys, signPIDs, transcript
+}
+
+// recordSigning signs msg with every party in signPIDs and returns the marshalled transcript of the first
+func recordSigning(t *testing.T, keys []keygen.LocalPartySaveData, signPIDs tss.SortedPartyIDs, msg *big.Int) []byte {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	hub := transport.NewHub()
+	defer hub.Close()
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	rec := tss.NewRecorder(signPIDs[0])
+
+	errCh := make(chan *tss.Error, len(signPIDs))
+	for i, pID := range signPIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(signPIDs), testThreshold)
+		if i == 0 {
+			params.SetRecorder(rec)
+		}
+		conn, err := hub.Connect(pID)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		outCh := make(chan tss.Message, 2*len(signPIDs))
+		endCh := make(chan *common.SignatureData, 1)
+		P := NewLocalParty(msg, params, keys[i], outCh, endCh)
+		go func() {
+			_, err := tss.Run(ctx, P, conn, outCh, endCh)
+			errCh <- err
+		}()
+	}
+	for range signPIDs {
+		if err := <-errCh; err != nil {
+			t.Fatalf("signing failed: %v", err)
+		}
+	}
+	bz, err := rec.Transcript().Marshal()
+	if err != nil {
+		t.Fatalf("unable to marshal the transcript: %v", err)
+	}
+	return bz
+}
+
 func TestFillTo32BytesInPlace(t *testing.T) {
 	s := big.NewInt(123456789)
 	normalizedS := padToLengthBytesInPlace(s.Bytes(), 32)
@@ -60,8 +60,12 @@ func NewSignRound1Message1(
 
 func (m *SignRound1Message1) ValidateBasic() bool {
 	return m != nil &&
-		common.NonEmptyBytes(m.GetC()) &&
-		common.NonEmptyMultiBytes(m.GetRangeProofAlice(), mta.RangeProofAliceBytesParts)
+		common.BoundedBytes(m.GetC(), tss.MaxCiphertextBytes) &&
+		common.BoundedMultiBytes(m.GetRangeProofAlice(), tss.MaxProofPartBytes, mta.RangeProofAliceBytesParts)
+}
+
+func (m *SignRound1Message1) RoundNumber() int {
+	return 1
 }
 
 func (m *SignRound1Message1) UnmarshalC() *big.Int {
@@ -91,7 +95,11 @@ func NewSignRound1Message2(
 
 func (m *SignRound1Message2) ValidateBasic() bool {
 	return m.Commitment != nil &&
-		common.NonEmptyBytes(m.GetCommitment())
+		common.BoundedBytes(m.GetCommitment(), tss.MaxHashBytes)
+}
+
+func (m *SignRound1Message2) RoundNumber() int {
+	return 1
 }
 
 func (m *SignRound1Message2) UnmarshalCommitment() *big.Int {
@@ -126,10 +134,14 @@ func NewSignRound2Message(
 
 func (m *SignRound2Message) ValidateBasic() bool {
 	return m != nil &&
-		common.NonEmptyBytes(m.C1) &&
-		common.NonEmptyBytes(m.C2) &&
-		common.NonEmptyMultiBytes(m.ProofBob, mta.ProofBobBytesParts) &&
-		common.NonEmptyMultiBytes(m.ProofBobWc, mta.ProofBobWCBytesParts)
+		common.BoundedBytes(m.C1, tss.MaxCiphertextBytes) &&
+		common.BoundedBytes(m.C2, tss.MaxCiphertextBytes) &&
+		common.BoundedMultiBytes(m.ProofBob, tss.MaxProofPartBytes, mta.ProofBobBytesParts) &&
+		common.BoundedMultiBytes(m.ProofBobWc, tss.MaxProofPartBytes, mta.ProofBobWCBytesParts)
+}
+
+func (m *SignRound2Message) RoundNumber() int {
+	return 2
 }
 
 func (m *SignRound2Message) UnmarshalProofBob() (*mta.ProofBob, error) {
@@ -159,7 +171,11 @@ func NewSignRound3Message(
 
 func (m *SignRound3Message) ValidateBasic() bool {
 	return m != nil &&
-		common.NonEmptyBytes(m.Theta)
+		common.BoundedBytes(m.Theta, tss.MaxScalarBytes)
+}
+
+func (m *SignRound3Message) RoundNumber() int {
+	return 3
 }
 
 // ----- //
@@ -186,10 +202,14 @@ func NewSignRound4Message(
 
 func (m *SignRound4Message) ValidateBasic() bool {
 	return m != nil &&
-		common.NonEmptyMultiBytes(m.DeCommitment, 3) &&
-		common.NonEmptyBytes(m.ProofAlphaX) &&
-		common.NonEmptyBytes(m.ProofAlphaY) &&
-		common.NonEmptyBytes(m.ProofT)
+		common.BoundedMultiBytes(m.DeCommitment, tss.MaxScalarBytes, 3) &&
+		common.BoundedBytes(m.ProofAlphaX, tss.MaxScalarBytes) &&
+		common.BoundedBytes(m.ProofAlphaY, tss.MaxScalarBytes) &&
+		common.BoundedBytes(m.ProofT, tss.MaxScalarBytes)
+}
+
+func (m *SignRound4Message) RoundNumber() int {
+	return 4
 }
 
 func (m *SignRound4Message) UnmarshalDeCommitment() []*big.Int {
@@ -230,7 +250,11 @@ func NewSignRound5Message(
 
 func (m *SignRound5Message) ValidateBasic() bool {
 	return m != nil &&
-		common.NonEmptyBytes(m.Commitment)
+		common.BoundedBytes(m.Commitment, tss.MaxHashBytes)
+}
+
+func (m *SignRound5Message) RoundNumber() int {
+	return 5
 }
 
 func (m *SignRound5Message) UnmarshalCommitment() *big.Int {
@@ -266,14 +290,18 @@ func NewSignRound6Message(
 
 func (m *SignRound6Message) ValidateBasic() bool {
 	return m != nil &&
-		common.NonEmptyMultiBytes(m.DeCommitment, 5) &&
-		common.NonEmptyBytes(m.ProofAlphaX) &&
-		common.NonEmptyBytes(m.ProofAlphaY) &&
-		common.NonEmptyBytes(m.ProofT) &&
-		common.NonEmptyBytes(m.VProofAlphaX) &&
-		common.NonEmptyBytes(m.VProofAlphaY) &&
-		common.NonEmptyBytes(m.VProofT) &&
-		common.NonEmptyBytes(m.VProofU)
+		common.BoundedMultiBytes(m.DeCommitment, tss.MaxScalarBytes, 5) &&
+		common.BoundedBytes(m.ProofAlphaX, tss.MaxScalarBytes) &&
+		common.BoundedBytes(m.ProofAlphaY, tss.MaxScalarBytes) &&
+		common.BoundedBytes(m.ProofT, tss.MaxScalarBytes) &&
+		common.BoundedBytes(m.VProofAlphaX, tss.MaxScalarBytes) &&
+		common.BoundedBytes(m.VProofAlphaY, tss.MaxScalarBytes) &&
+		common.BoundedBytes(m.VProofT, tss.MaxScalarBytes) &&
+		common.BoundedBytes(m.VProofU, tss.MaxScalarBytes)
+}
+
+func (m *SignRound6Message) RoundNumber() int {
+	return 6
 }
 
 func (m *SignRound6Message) UnmarshalDeCommitment() []*big.Int {
@@ -329,7 +357,11 @@ func NewSignRound7Message(
 
 func (m *SignRound7Message) ValidateBasic() bool {
 	return m != nil &&
-		common.NonEmptyBytes(m.Commitment)
+		common.BoundedBytes(m.Commitment, tss.MaxHashBytes)
+}
+
+func (m *SignRound7Message) RoundNumber() int {
+	return 7
 }
 
 func (m *SignRound7Message) UnmarshalCommitment() *big.Int {
@@ -356,7 +388,11 @@ func NewSignRound8Message(
 
 func (m *SignRound8Message) ValidateBasic() bool {
 	return m != nil &&
-		common.NonEmptyMultiBytes(m.DeCommitment, 5)
+		common.BoundedMultiBytes(m.DeCommitment, tss.MaxScalarBytes, 5)
+}
+
+func (m *SignRound8Message) RoundNumber() int {
+	return 8
 }
 
 func (m *SignRound8Message) UnmarshalDeCommitment() []*big.Int {
@@ -383,7 +419,11 @@ func NewSignRound9Message(
 
 func (m *SignRound9Message) ValidateBasic() bool {
 	return m != nil &&
-		common.NonEmptyBytes(m.S)
+		common.BoundedBytes(m.S, tss.MaxScalarBytes)
+}
+
+func (m *SignRound9Message) RoundNumber() int {
+	return 9
 }
 
 func (m *SignRound9Message) UnmarshalS() *big.Int {
@@ -19,8 +19,6 @@ import (
 	"github.com/SafeMPC/tss-lib/tss"
 )
 
-var zero = big.NewInt(0)
-
 // round 1 represents round 1 of the signing part of the GG18 ECDSA TSS spec (Gennaro, Goldfeder; 2018)
 func newRound1(params *tss.Parameters, key *keygen.LocalPartySaveData, data *common.SignatureData, temp *localTempData, out chan<- tss.Message, end chan<- *common.SignatureData) tss.Round {
 	return &round1{
@@ -41,10 +39,15 @@ func (round *round1) Start() *tss.Error {
 		return round.WrapError(errors.New("hashed message is not valid"))
 	}
 
+	// the moduli of the peers must have the size that keygen recorded, rather than merely any size
+	if j, err := round.key.ValidateModuli(); err != nil {
+		return round.WrapError(tss.Classify(err, tss.ErrInvalidParameters), round.Parties().IDs()[j])
+	}
+
 	round.number = 1
 	round.started = true
 	round.resetOK()
-	round.temp.ssidNonce = new(big.Int).SetUint64(0)
+	round.temp.ssidNonce = round.SSIDNonce()
 	ssid, err := round.getSSID()
 	if err != nil {
 		return round.WrapError(err)
@@ -68,7 +71,14 @@ func (round *round1) Start() *tss.Error {
 		if j == i {
 			continue
 		}
-		cA, pi, err := mta.AliceInit(round.Params().EC(), round.key.PaillierPKs[i], k, round.key.NTildej[j], round.key.H1j[j], round.key.H2j[j], round.Rand())
+		// the range proof is bound to the context of its recipient, Bob, who verifies it in round 2
+		ContextJ := append(round.temp.ssid, new(big.Int).SetUint64(uint64(j)).Bytes()...)
+		var cA *big.Int
+		var pi *mta.RangeProofAlice
+		var err error
+		common.RunOn(round.Executor(), func() {
+			cA, pi, err = mta.AliceInit(ContextJ, round.Params().EC(), round.key.PaillierPKs[i], k, round.key.NTildej[j], round.key.H1j[j], round.key.H2j[j], round.Rand())
+		})
 		if err != nil {
 			return round.WrapError(fmt.Errorf("failed to init mta: %v", err))
 		}
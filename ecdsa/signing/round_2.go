@@ -30,6 +30,7 @@ func (round *round2) Start() *tss.Error {
 
 	errChs := make(chan *tss.Error, (len(round.Parties().IDs())-1)*2)
 	wg := sync.WaitGroup{}
+	exec := round.Executor()
 	wg.Add((len(round.Parties().IDs()) - 1) * 2)
 	ContextI := append(round.temp.ssid, new(big.Int).SetUint64(uint64(i)).Bytes()...)
 	for j, Pj := range round.Parties().IDs() {
@@ -37,12 +38,12 @@ func (round *round2) Start() *tss.Error {
 			continue
 		}
 		// Bob_mid
-		go func(j int, Pj *tss.PartyID) {
+		exec.Go(func() {
 			defer wg.Done()
 			r1msg := round.temp.signRound1Message1s[j].Content().(*SignRound1Message1)
 			rangeProofAliceJ, err := r1msg.UnmarshalRangeProofAlice()
 			if err != nil {
-				errChs <- round.WrapError(errorspkg.Wrapf(err, "UnmarshalRangeProofAlice failed"), Pj)
+				errChs <- round.WrapError(tss.Classify(errorspkg.Wrapf(err, "UnmarshalRangeProofAlice failed"), tss.ErrBadMessage), Pj)
 				return
 			}
 			beta, c1ji, _, pi1ji, err := mta.BobMid(
@@ -67,14 +68,14 @@ func (round *round2) Start() *tss.Error {
 			if err != nil {
 				errChs <- round.WrapError(err, Pj)
 			}
-		}(j, Pj)
+		})
 		// Bob_mid_wc
-		go func(j int, Pj *tss.PartyID) {
+		exec.Go(func() {
 			defer wg.Done()
 			r1msg := round.temp.signRound1Message1s[j].Content().(*SignRound1Message1)
 			rangeProofAliceJ, err := r1msg.UnmarshalRangeProofAlice()
 			if err != nil {
-				errChs <- round.WrapError(errorspkg.Wrapf(err, "UnmarshalRangeProofAlice failed"), Pj)
+				errChs <- round.WrapError(tss.Classify(errorspkg.Wrapf(err, "UnmarshalRangeProofAlice failed"), tss.ErrBadMessage), Pj)
 				return
 			}
 			v, c2ji, _, pi2ji, err := mta.BobMidWC(
@@ -99,7 +100,7 @@ func (round *round2) Start() *tss.Error {
 			if err != nil {
 				errChs <- round.WrapError(err, Pj)
 			}
-		}(j, Pj)
+		})
 	}
 	// consume error channels; wait for goroutines
 	wg.Wait()
@@ -109,7 +110,8 @@ func (round *round2) Start() *tss.Error {
 		culprits = append(culprits, err.Culprits()...)
 	}
 	if len(culprits) > 0 {
-		return round.WrapError(errors.New("failed to calculate Bob_mid or Bob_mid_wc"), culprits...)
+		return round.WrapError(tss.Classify(errors.New("failed to calculate Bob_mid or Bob_mid_wc"), tss.ErrInvalidProof), culprits...).
+			WithMessages(culpritMessages(round.temp.signRound1Message1s, culprits)...)
 	}
 	// create and send messages
 	for j, Pj := range round.Parties().IDs() {
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"math/big"
 	"sync"
+	"time"
 
 	errorspkg "github.com/pkg/errors"
 
@@ -33,6 +34,7 @@ func (round *round3) Start() *tss.Error {
 
 	errChs := make(chan *tss.Error, (len(round.Parties().IDs())-1)*2)
 	wg := sync.WaitGroup{}
+	exec := round.Executor()
 	wg.Add((len(round.Parties().IDs()) - 1) * 2)
 	for j, Pj := range round.Parties().IDs() {
 		if j == i {
@@ -40,14 +42,15 @@ func (round *round3) Start() *tss.Error {
 		}
 		ContextJ := append(round.temp.ssid, new(big.Int).SetUint64(uint64(j)).Bytes()...)
 		// Alice_end
-		go func(j int, Pj *tss.PartyID) {
+		exec.Go(func() {
 			defer wg.Done()
 			r2msg := round.temp.signRound2Messages[j].Content().(*SignRound2Message)
 			proofBob, err := r2msg.UnmarshalProofBob()
 			if err != nil {
-				errChs <- round.WrapError(errorspkg.Wrapf(err, "UnmarshalProofBob failed"), Pj)
+				errChs <- round.WrapError(tss.Classify(errorspkg.Wrapf(err, "UnmarshalProofBob failed"), tss.ErrBadMessage), Pj)
 				return
 			}
+			start := time.Now()
 			alphaIj, err := mta.AliceEnd(
 				ContextJ,
 				round.Params().EC(),
@@ -59,20 +62,22 @@ func (round *round3) Start() *tss.Error {
 				new(big.Int).SetBytes(r2msg.GetC1()),
 				round.key.NTildej[i],
 				round.key.PaillierSK)
+			round.ObserveProof(TaskName, round.number, "mta_bob", start, err == nil)
 			alphas[j] = alphaIj
 			if err != nil {
 				errChs <- round.WrapError(err, Pj)
 			}
-		}(j, Pj)
+		})
 		// Alice_end_wc
-		go func(j int, Pj *tss.PartyID) {
+		exec.Go(func() {
 			defer wg.Done()
 			r2msg := round.temp.signRound2Messages[j].Content().(*SignRound2Message)
 			proofBobWC, err := r2msg.UnmarshalProofBobWC(round.Parameters.EC())
 			if err != nil {
-				errChs <- round.WrapError(errorspkg.Wrapf(err, "UnmarshalProofBobWC failed"), Pj)
+				errChs <- round.WrapError(tss.Classify(errorspkg.Wrapf(err, "UnmarshalProofBobWC failed"), tss.ErrBadMessage), Pj)
 				return
 			}
+			start := time.Now()
 			uIj, err := mta.AliceEndWC(
 				ContextJ,
 				round.Params().EC(),
@@ -85,11 +90,12 @@ func (round *round3) Start() *tss.Error {
 				round.key.H1j[i],
 				round.key.H2j[i],
 				round.key.PaillierSK)
+			round.ObserveProof(TaskName, round.number, "mta_bob_wc", start, err == nil)
 			us[j] = uIj
 			if err != nil {
 				errChs <- round.WrapError(err, Pj)
 			}
-		}(j, Pj)
+		})
 	}
 
 	// consume error channels; wait for goroutines
@@ -100,7 +106,8 @@ func (round *round3) Start() *tss.Error {
 		culprits = append(culprits, err.Culprits()...)
 	}
 	if len(culprits) > 0 {
-		return round.WrapError(errors.New("failed to calculate Alice_end or Alice_end_wc"), culprits...)
+		return round.WrapError(tss.Classify(errors.New("failed to calculate Alice_end or Alice_end_wc"), tss.ErrInvalidProof), culprits...).
+			WithMessages(culpritMessages(round.temp.signRound2Messages, culprits)...)
 	}
 
 	modN := common.ModInt(round.Params().EC().Params().N)
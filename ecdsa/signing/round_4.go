@@ -28,15 +28,20 @@ func (round *round4) Start() *tss.Error {
 	theta := *round.temp.theta
 	thetaInverse := &theta
 
-	modN := common.ModInt(round.Params().EC().Params().N)
+	N := round.Params().EC().Params().N
+	modN := common.ModInt(N)
 
-	for j := range round.Parties().IDs() {
+	for j, Pj := range round.Parties().IDs() {
 		if j == round.PartyID().Index {
 			continue
 		}
 		r3msg := round.temp.signRound3Messages[j].Content().(*SignRound3Message)
-		theltaJ := r3msg.GetTheta()
-		thetaInverse = modN.Add(thetaInverse, new(big.Int).SetBytes(theltaJ))
+		theltaJ := new(big.Int).SetBytes(r3msg.GetTheta())
+		if !common.IsInInterval(theltaJ, N) {
+			return round.WrapError(tss.Classify(errors.New("theta is not less than the curve order"), tss.ErrBadMessage), Pj).
+				WithMessages(round.temp.signRound3Messages[j])
+		}
+		thetaInverse = modN.Add(thetaInverse, theltaJ)
 	}
 
 	// compute the multiplicative inverse thelta mod q
@@ -38,23 +38,28 @@ func (round *round5) Start() *tss.Error {
 		cmtDeCmt := commitments.HashCommitDecommit{C: SCj, D: SDj}
 		ok, bigGammaJ := cmtDeCmt.DeCommit()
 		if !ok || len(bigGammaJ) != 2 {
-			return round.WrapError(errors.New("commitment verify failed"), Pj)
+			return round.WrapError(tss.Classify(errors.New("commitment verify failed"), tss.ErrCommitmentMismatch), Pj).
+				WithMessages(round.temp.signRound1Message2s[j], round.temp.signRound4Messages[j])
 		}
 		bigGammaJPoint, err := crypto.NewECPoint(round.Params().EC(), bigGammaJ[0], bigGammaJ[1])
 		if err != nil {
-			return round.WrapError(errors2.Wrapf(err, "NewECPoint(bigGammaJ)"), Pj)
+			return round.WrapError(tss.Classify(errors2.Wrapf(err, "NewECPoint(bigGammaJ)"), tss.ErrBadMessage), Pj).
+				WithMessages(round.temp.signRound1Message2s[j], round.temp.signRound4Messages[j])
 		}
 		proof, err := r4msg.UnmarshalZKProof(round.Params().EC())
 		if err != nil {
-			return round.WrapError(errors.New("failed to unmarshal bigGamma proof"), Pj)
+			return round.WrapError(tss.Classify(errors.New("failed to unmarshal bigGamma proof"), tss.ErrBadMessage), Pj).
+				WithMessages(round.temp.signRound1Message2s[j], round.temp.signRound4Messages[j])
 		}
-		ok = proof.Verify(ContextJ, bigGammaJPoint)
+		ok = round.verifyProof("schnorr", func() bool { return proof.Verify(ContextJ, bigGammaJPoint) })
 		if !ok {
-			return round.WrapError(errors.New("failed to prove bigGamma"), Pj)
+			return round.WrapError(tss.Classify(errors.New("failed to prove bigGamma"), tss.ErrInvalidProof), Pj).
+				WithMessages(round.temp.signRound1Message2s[j], round.temp.signRound4Messages[j])
 		}
 		R, err = R.Add(bigGammaJPoint)
 		if err != nil {
-			return round.WrapError(errors2.Wrapf(err, "R.Add(bigGammaJ)"), Pj)
+			return round.WrapError(errors2.Wrapf(err, "R.Add(bigGammaJ)"), Pj).
+				WithMessages(round.temp.signRound1Message2s[j], round.temp.signRound4Messages[j])
 		}
 	}
 
@@ -65,9 +70,8 @@ func (round *round5) Start() *tss.Error {
 	ry := R.Y()
 	si := modN.Add(modN.Mul(round.temp.m, round.temp.k), modN.Mul(rx, round.temp.sigma))
 
-	// clear temp.w and temp.k from memory, lint ignore
-	round.temp.w = zero
-	round.temp.k = zero
+	// clear temp.w and temp.k from memory
+	common.Zeroize(round.temp.w, round.temp.k)
 
 	li := common.GetRandomPositiveInt(round.Rand(), N)  // li
 	roI := common.GetRandomPositiveInt(round.Rand(), N) // pi
@@ -39,26 +39,31 @@ func (round *round7) Start() *tss.Error {
 		cmtDeCmt := commitments.HashCommitDecommit{C: cj, D: dj}
 		ok, values := cmtDeCmt.DeCommit()
 		if !ok || len(values) != 4 {
-			return round.WrapError(errors.New("de-commitment for bigVj and bigAj failed"), Pj)
+			return round.WrapError(tss.Classify(errors.New("de-commitment for bigVj and bigAj failed"), tss.ErrCommitmentMismatch), Pj).
+				WithMessages(round.temp.signRound5Messages[j], round.temp.signRound6Messages[j])
 		}
 		bigVjX, bigVjY, bigAjX, bigAjY := values[0], values[1], values[2], values[3]
 		bigVj, err := crypto.NewECPoint(round.Params().EC(), bigVjX, bigVjY)
 		if err != nil {
-			return round.WrapError(errors2.Wrapf(err, "NewECPoint(bigVj)"), Pj)
+			return round.WrapError(tss.Classify(errors2.Wrapf(err, "NewECPoint(bigVj)"), tss.ErrBadMessage), Pj).
+				WithMessages(round.temp.signRound5Messages[j], round.temp.signRound6Messages[j])
 		}
 		bigVjs[j] = bigVj
 		bigAj, err := crypto.NewECPoint(round.Params().EC(), bigAjX, bigAjY)
 		if err != nil {
-			return round.WrapError(errors2.Wrapf(err, "NewECPoint(bigAj)"), Pj)
+			return round.WrapError(tss.Classify(errors2.Wrapf(err, "NewECPoint(bigAj)"), tss.ErrBadMessage), Pj).
+				WithMessages(round.temp.signRound5Messages[j], round.temp.signRound6Messages[j])
 		}
 		bigAjs[j] = bigAj
 		pijA, err := r6msg.UnmarshalZKProof(round.Params().EC())
-		if err != nil || !pijA.Verify(ContextJ, bigAj) {
-			return round.WrapError(errors.New("schnorr verify for Aj failed"), Pj)
+		if err != nil || !round.verifyProof("schnorr", func() bool { return pijA.Verify(ContextJ, bigAj) }) {
+			return round.WrapError(tss.Classify(errors.New("schnorr verify for Aj failed"), tss.ErrInvalidProof), Pj).
+				WithMessages(round.temp.signRound5Messages[j], round.temp.signRound6Messages[j])
 		}
 		pijV, err := r6msg.UnmarshalZKVProof(round.Params().EC())
-		if err != nil || !pijV.Verify(ContextJ, bigVj, round.temp.bigR) {
-			return round.WrapError(errors.New("vverify for Vj failed"), Pj)
+		if err != nil || !round.verifyProof("schnorr_v", func() bool { return pijV.Verify(ContextJ, bigVj, round.temp.bigR) }) {
+			return round.WrapError(tss.Classify(errors.New("vverify for Vj failed"), tss.ErrInvalidProof), Pj).
+				WithMessages(round.temp.signRound5Messages[j], round.temp.signRound6Messages[j])
 		}
 	}
 
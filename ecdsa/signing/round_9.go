@@ -34,7 +34,8 @@ func (round *round9) Start() *tss.Error {
 		cmt := commitments.HashCommitDecommit{C: cj, D: dj}
 		ok, values := cmt.DeCommit()
 		if !ok && len(values) != 4 {
-			return round.WrapError(errors.New("de-commitment for bigVj and bigAj failed"), Pj)
+			return round.WrapError(tss.Classify(errors.New("de-commitment for bigVj and bigAj failed"), tss.ErrCommitmentMismatch), Pj).
+				WithMessages(round.temp.signRound7Messages[j], round.temp.signRound8Messages[j])
 		}
 		UjX, UjY, TjX, TjY := values[0], values[1], values[2], values[3]
 		UX, UY = round.Params().EC().Add(UX, UY, UjX, UjY)
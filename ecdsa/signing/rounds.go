@@ -9,6 +9,7 @@ package signing
 import (
 	"errors"
 	"math/big"
+	"time"
 
 	"github.com/SafeMPC/tss-lib/common"
 	"github.com/SafeMPC/tss-lib/crypto"
@@ -117,6 +118,21 @@ func (round *base) WrapError(err error, culprits ...*tss.PartyID) *tss.Error {
 	return tss.NewError(err, TaskName, round.number, round.PartyID(), culprits...)
 }
 
+// State is captured in a snapshot of the party
+func (round *base) State() tss.RoundState {
+	return tss.RoundState{Number: round.number, Started: round.started, OK: [][]bool{append([]bool{}, round.ok...)}}
+}
+
+// Restore puts a round created by NextRound() back into the state captured in a snapshot
+func (round *base) Restore(state tss.RoundState) error {
+	if len(state.OK) != 1 || len(state.OK[0]) != len(round.ok) {
+		return errors.New("the round state does not match the number of parties")
+	}
+	round.number, round.started = state.Number, state.Started
+	copy(round.ok, state.OK[0])
+	return nil
+}
+
 // ----- //
 
 // `ok` tracks parties which have been verified by Update()
@@ -126,6 +142,27 @@ func (round *base) resetOK() {
 	}
 }
 
+// verifyProof runs and times the verification of a proof, reporting it to the party's Observer
+func (round *base) verifyProof(proof string, verify func() bool) bool {
+	start := time.Now()
+	ok := verify()
+	round.ObserveProof(TaskName, round.number, proof, start, ok)
+	return ok
+}
+
+// culpritMessages returns the message of each culprit in msgs, which are indexed by party, once
+func culpritMessages(msgs []tss.ParsedMessage, culprits []*tss.PartyID) []tss.Message {
+	seen := make(map[int]bool, len(culprits))
+	evidence := make([]tss.Message, 0, len(culprits))
+	for _, culprit := range culprits {
+		if j := culprit.Index; 0 <= j && j < len(msgs) && !seen[j] {
+			seen[j] = true
+			evidence = append(evidence, msgs[j])
+		}
+	}
+	return evidence
+}
+
 // get ssid from local params
 func (round *base) getSSID() ([]byte, error) {
 	ssidList := []*big.Int{round.EC().Params().P, round.EC().Params().N, round.EC().Params().B, round.EC().Params().Gx, round.EC().Params().Gy} // ec curve
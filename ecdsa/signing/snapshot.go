@@ -0,0 +1,173 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/SafeMPC/tss-lib/common"
+	"github.com/SafeMPC/tss-lib/crypto"
+	cmt "github.com/SafeMPC/tss-lib/crypto/commitments"
+	"github.com/SafeMPC/tss-lib/crypto/mta"
+	"github.com/SafeMPC/tss-lib/ecdsa/keygen"
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+// snapshotData is the state of an in-progress signing that is encrypted into a snapshot
+type snapshotData struct {
+	Keys keygen.LocalPartySaveData
+	Data []byte
+
+	// round 1
+	W, M, K, Theta, ThetaInverse, Sigma, KeyDerivationDelta, Gamma *big.Int
+	FullBytesLen                                                   int
+	Cis                                                            []*big.Int
+	BigWs                                                          []*crypto.ECPoint
+	PointGamma                                                     *crypto.ECPoint
+	DeCommit                                                       cmt.HashDeCommitment
+
+	// round 2
+	Betas, C1jis, C2jis, Vs []*big.Int
+	Pi1jis                  []*mta.ProofBob
+	Pi2jis                  []*mta.ProofBobWC
+
+	// round 5
+	Li, Si, Rx, Ry, Roi *big.Int
+	BigR, BigAi, BigVi  *crypto.ECPoint
+	DPower              cmt.HashDeCommitment
+
+	// round 7
+	Ui, Ti *crypto.ECPoint
+	DTelda cmt.HashDeCommitment
+
+	SSIDNonce *big.Int
+	SSID      []byte
+}
+
+// Snapshot captures the state of the party, including its secrets, so that it can be resumed with RestoreLocalParty
+// after a restart. The snapshot is encrypted under the parameters' snapshot key.
+func (p *LocalParty) Snapshot() ([]byte, error) {
+	return tss.BaseSnapshot(p, TaskName, func() ([]tss.ParsedMessage, []byte, error) {
+		data, err := proto.Marshal(p.data)
+		if err != nil {
+			return nil, nil, err
+		}
+		secrets, err := json.Marshal(&snapshotData{
+			Keys:               p.keys,
+			Data:               data,
+			W:                  p.temp.w,
+			M:                  p.temp.m,
+			K:                  p.temp.k,
+			Theta:              p.temp.theta,
+			ThetaInverse:       p.temp.thetaInverse,
+			Sigma:              p.temp.sigma,
+			KeyDerivationDelta: p.temp.keyDerivationDelta,
+			Gamma:              p.temp.gamma,
+			FullBytesLen:       p.temp.fullBytesLen,
+			Cis:                p.temp.cis,
+			BigWs:              p.temp.bigWs,
+			PointGamma:         p.temp.pointGamma,
+			DeCommit:           p.temp.deCommit,
+			Betas:              p.temp.betas,
+			C1jis:              p.temp.c1jis,
+			C2jis:              p.temp.c2jis,
+			Vs:                 p.temp.vs,
+			Pi1jis:             p.temp.pi1jis,
+			Pi2jis:             p.temp.pi2jis,
+			Li:                 p.temp.li,
+			Si:                 p.temp.si,
+			Rx:                 p.temp.rx,
+			Ry:                 p.temp.ry,
+			Roi:                p.temp.roi,
+			BigR:               p.temp.bigR,
+			BigAi:              p.temp.bigAi,
+			BigVi:              p.temp.bigVi,
+			DPower:             p.temp.DPower,
+			Ui:                 p.temp.Ui,
+			Ti:                 p.temp.Ti,
+			DTelda:             p.temp.DTelda,
+			SSIDNonce:          p.temp.ssidNonce,
+			SSID:               p.temp.ssid,
+		})
+		return p.temp.messages(), secrets, err
+	})
+}
+
+// RestoreLocalParty recreates a party from its Snapshot. The message, the key and the key derivation delta are restored
+// from the snapshot. The party continues in the round that it was in without starting it again, so no fresh nonces
+// are drawn; it must not be started.
+func RestoreLocalParty(
+	params *tss.Parameters,
+	snapshot []byte,
+	out chan<- tss.Message,
+	end chan<- *common.SignatureData,
+) (tss.Party, error) {
+	return tss.BaseRestore(params, snapshot, TaskName, func(secrets []byte) (tss.Party, error) {
+		data := new(snapshotData)
+		if err := json.Unmarshal(secrets, data); err != nil {
+			return nil, err
+		}
+		p := NewLocalPartyWithKDD(data.M, params, data.Keys, data.KeyDerivationDelta, out, end, data.FullBytesLen).(*LocalParty)
+		if err := proto.Unmarshal(data.Data, p.data); err != nil {
+			return nil, err
+		}
+		p.keys = data.Keys
+		p.temp.w = data.W
+		p.temp.k = data.K
+		p.temp.theta = data.Theta
+		p.temp.thetaInverse = data.ThetaInverse
+		p.temp.sigma = data.Sigma
+		p.temp.gamma = data.Gamma
+		p.temp.cis = data.Cis
+		p.temp.bigWs = data.BigWs
+		p.temp.pointGamma = data.PointGamma
+		p.temp.deCommit = data.DeCommit
+		p.temp.betas = data.Betas
+		p.temp.c1jis = data.C1jis
+		p.temp.c2jis = data.C2jis
+		p.temp.vs = data.Vs
+		p.temp.pi1jis = data.Pi1jis
+		p.temp.pi2jis = data.Pi2jis
+		p.temp.li = data.Li
+		p.temp.si = data.Si
+		p.temp.rx = data.Rx
+		p.temp.ry = data.Ry
+		p.temp.roi = data.Roi
+		p.temp.bigR = data.BigR
+		p.temp.bigAi = data.BigAi
+		p.temp.bigVi = data.BigVi
+		p.temp.DPower = data.DPower
+		p.temp.Ui = data.Ui
+		p.temp.Ti = data.Ti
+		p.temp.DTelda = data.DTelda
+		p.temp.ssidNonce = data.SSIDNonce
+		p.temp.ssid = data.SSID
+		return p, nil
+	})
+}
+
+func (store *localMessageStore) messages() []tss.ParsedMessage {
+	var msgs []tss.ParsedMessage
+	for _, stored := range [][]tss.ParsedMessage{
+		store.signRound1Message1s,
+		store.signRound1Message2s,
+		store.signRound2Messages,
+		store.signRound3Messages,
+		store.signRound4Messages,
+		store.signRound5Messages,
+		store.signRound6Messages,
+		store.signRound7Messages,
+		store.signRound8Messages,
+		store.signRound9Messages,
+	} {
+		msgs = append(msgs, stored...)
+	}
+	return msgs
+}
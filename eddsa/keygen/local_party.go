@@ -66,7 +66,7 @@ func NewLocalParty(
 	partyCount := params.PartyCount()
 	data := NewLocalPartySaveData(partyCount)
 	p := &LocalParty{
-		BaseParty: new(tss.BaseParty),
+		BaseParty: tss.NewBaseParty(out),
 		params:    params,
 		temp:      localTempData{},
 		data:      data,
@@ -95,10 +95,18 @@ func (p *LocalParty) Update(msg tss.ParsedMessage) (ok bool, err *tss.Error) {
 	return tss.BaseUpdate(p, msg, TaskName)
 }
 
+// Abort stops the party, overwrites the secrets in its temp data and drops the messages that it received
+func (p *LocalParty) Abort(reason error) *tss.Error {
+	return tss.BaseAbort(p, TaskName, reason, func() {
+		p.temp.zeroize()
+		p.temp.localMessageStore = localMessageStore{}
+	})
+}
+
 func (p *LocalParty) UpdateFromBytes(wireBytes []byte, from *tss.PartyID, isBroadcast bool) (bool, *tss.Error) {
-	msg, err := tss.ParseWireMessage(wireBytes, from, isBroadcast)
+	msg, err := p.params.ParseWireMessage(wireBytes, from, isBroadcast)
 	if err != nil {
-		return false, p.WrapError(err)
+		return false, tss.WrapParseError(p, err, wireBytes, from, isBroadcast)
 	}
 	return p.Update(msg)
 }
@@ -109,8 +117,8 @@ func (p *LocalParty) ValidateMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	}
 	// check that the message's "from index" will fit into the array
 	if maxFromIdx := p.params.PartyCount() - 1; maxFromIdx < msg.GetFrom().Index {
-		return false, p.WrapError(fmt.Errorf("received msg with a sender index too great (%d <= %d)",
-			p.params.PartyCount(), msg.GetFrom().Index), msg.GetFrom())
+		return false, p.WrapError(tss.Classify(fmt.Errorf("received msg with a sender index too great (%d <= %d)",
+			p.params.PartyCount(), msg.GetFrom().Index), tss.ErrBadMessage), msg.GetFrom()).WithMessages(msg)
 	}
 	return true, nil
 }
@@ -123,19 +131,20 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	fromPIdx := msg.GetFrom().Index
 
 	// switch/case is necessary to store any messages beyond current round
-	// this does not handle message replays. we expect the caller to apply replay and spoofing protection.
+	// a repeated message from the same sender is ignored if identical and rejected as equivocation otherwise.
+	var slot *tss.ParsedMessage
 	switch msg.Content().(type) {
 	case *KGRound1Message:
-		p.temp.kgRound1Messages[fromPIdx] = msg
+		slot = &p.temp.kgRound1Messages[fromPIdx]
 	case *KGRound2Message1:
-		p.temp.kgRound2Message1s[fromPIdx] = msg
+		slot = &p.temp.kgRound2Message1s[fromPIdx]
 	case *KGRound2Message2:
-		p.temp.kgRound2Message2s[fromPIdx] = msg
+		slot = &p.temp.kgRound2Message2s[fromPIdx]
 	default: // unrecognised message, just ignore!
-		common.Logger.Warningf("unrecognised message ignored: %v", msg)
+		p.params.Logger().Warn("unrecognised message ignored", "task", TaskName, "msg", msg.String())
 		return false, nil
 	}
-	return true, nil
+	return tss.StoreMessageOnce(p, slot, msg)
 }
 
 // recovers a party's original index in the set of parties during keygen
@@ -162,3 +171,11 @@ func (p *LocalParty) PartyID() *tss.PartyID {
 func (p *LocalParty) String() string {
 	return fmt.Sprintf("id: %s, %s", p.PartyID(), p.BaseParty.String())
 }
+
+// zeroize overwrites the secrets in the temp data; the party does so itself once it has output its result
+func (temp *localTempData) zeroize() {
+	common.Zeroize(temp.ui)
+	for _, share := range temp.shares {
+		common.Zeroize(share.Share)
+	}
+}
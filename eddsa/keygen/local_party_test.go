@@ -24,6 +24,7 @@ import (
 	"github.com/SafeMPC/tss-lib/crypto/vss"
 	"github.com/SafeMPC/tss-lib/test"
 	"github.com/SafeMPC/tss-lib/tss"
+	"github.com/SafeMPC/tss-lib/tss/transport"
 )
 
 const (
@@ -37,6 +38,37 @@ func setUp(level string) {
 	}
 }
 
+func TestConflictingMessageCulprits(t *testing.T) {
+	setUp("info")
+
+	pIDs := tss.GenerateTestPartyIDs(3)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[0], len(pIDs), 1)
+	lp := NewLocalParty(params, make(chan tss.Message, len(pIDs)), nil).(*LocalParty)
+	if err := lp.Start(); err != nil {
+		assert.FailNow(t, err.Error())
+	}
+
+	msg := NewKGRound1Message(pIDs[1], big.NewInt(1))
+	ok, err := lp.Update(msg)
+	assert.True(t, ok)
+	assert.Nil(t, err)
+
+	// an identical copy is ignored
+	ok, err = lp.Update(NewKGRound1Message(pIDs[1], big.NewInt(1)))
+	assert.True(t, ok)
+	assert.Nil(t, err)
+
+	// a different message for the same round is equivocation
+	ok, err = lp.Update(NewKGRound1Message(pIDs[1], big.NewInt(2)))
+	assert.False(t, ok)
+	if assert.NotNil(t, err) {
+		assert.Equal(t, []*tss.PartyID{pIDs[1]}, err.Culprits())
+		assert.ErrorIs(t, err, tss.ErrEquivocation)
+	}
+	assert.Equal(t, msg, lp.temp.kgRound1Messages[pIDs[1].Index])
+}
+
 func TestE2EConcurrentAndSaveFixtures(t *testing.T) {
 	setUp("info")
 
@@ -54,7 +86,9 @@ func TestE2EConcurrentAndSaveFixtures(t *testing.T) {
 	outCh := make(chan tss.Message, len(pIDs))
 	endCh := make(chan *LocalPartySaveData, len(pIDs))
 
-	updater := test.SharedPartyUpdater
+	hub := transport.NewHub()
+	defer hub.Close()
+	updater := test.SharedTransportUpdater
 
 	startGR := runtime.NumGoroutine()
 
@@ -68,6 +102,9 @@ func TestE2EConcurrentAndSaveFixtures(t *testing.T) {
 			P = NewLocalParty(params, outCh, endCh).(*LocalParty)
 		}
 		parties = append(parties, P)
+		conn, err := hub.Connect(P.PartyID())
+		assert.NoError(t, err)
+		go updater(P, conn, errCh)
 		go func(P *LocalParty) {
 			if err := P.Start(); err != nil {
 				errCh <- err
@@ -87,20 +124,8 @@ keygen:
 			break keygen
 
 		case msg := <-outCh:
-			dest := msg.GetTo()
-			if dest == nil { // broadcast!
-				for _, P := range parties {
-					if P.PartyID().Index == msg.GetFrom().Index {
-						continue
-					}
-					go updater(P, msg, errCh)
-				}
-			} else { // point-to-point!
-				if dest[0].Index == msg.GetFrom().Index {
-					t.Fatalf("party %d tried to send a message to itself (%d)", dest[0].Index, msg.GetFrom().Index)
-					return
-				}
-				go updater(parties[dest[0].Index], msg, errCh)
+			if err := hub.Send(msg); err != nil {
+				assert.FailNow(t, err.Error())
 			}
 
 		case save := <-endCh:
@@ -135,7 +160,7 @@ keygen:
 					assert.NoError(t, err, "vss.ReConstruct should not throw error")
 
 					// uG test: u*G[j] == V[0]
-					assert.Equal(t, uj, Pj.temp.ui)
+					assert.Zero(t, Pj.temp.ui.Sign(), "ui must be zeroized once keygen has finished")
 					uG := crypto.ScalarBaseMult(tss.Edwards(), uj)
 					assert.True(t, uG.Equals(Pj.temp.vs[0]), "ensure u*G[j] == V_0")
 
@@ -149,10 +174,10 @@ keygen:
 					{
 						badShares := pShares[:threshold]
 						badShares[len(badShares)-1].Share.Set(big.NewInt(0))
-						uj, err := pShares[:threshold].ReConstruct(tss.Edwards())
+						badUj, err := pShares[:threshold].ReConstruct(tss.Edwards())
 						assert.NoError(t, err)
-						assert.NotEqual(t, parties[j].temp.ui, uj)
-						BigXjX, BigXjY := tss.Edwards().ScalarBaseMult(uj.Bytes())
+						assert.NotEqual(t, uj, badUj)
+						BigXjX, BigXjY := tss.Edwards().ScalarBaseMult(badUj.Bytes())
 						assert.NotEqual(t, BigXjX, Pj.temp.vs[0].X())
 						assert.NotEqual(t, BigXjY, Pj.temp.vs[0].Y())
 					}
@@ -45,7 +45,11 @@ func NewKGRound1Message(from *tss.PartyID, ct cmt.HashCommitment) tss.ParsedMess
 }
 
 func (m *KGRound1Message) ValidateBasic() bool {
-	return m != nil && common.NonEmptyBytes(m.GetCommitment())
+	return m != nil && common.BoundedBytes(m.GetCommitment(), tss.MaxHashBytes)
+}
+
+func (m *KGRound1Message) RoundNumber() int {
+	return 1
 }
 
 func (m *KGRound1Message) UnmarshalCommitment() *big.Int {
@@ -72,7 +76,11 @@ func NewKGRound2Message1(
 
 func (m *KGRound2Message1) ValidateBasic() bool {
 	return m != nil &&
-		common.NonEmptyBytes(m.GetShare())
+		common.BoundedBytes(m.GetShare(), tss.MaxScalarBytes)
+}
+
+func (m *KGRound2Message1) RoundNumber() int {
+	return 2
 }
 
 func (m *KGRound2Message1) UnmarshalShare() *big.Int {
@@ -103,7 +111,14 @@ func NewKGRound2Message2(
 
 func (m *KGRound2Message2) ValidateBasic() bool {
 	return m != nil &&
-		common.NonEmptyMultiBytes(m.GetDeCommitment())
+		common.BoundedMultiBytes(m.GetDeCommitment(), tss.MaxScalarBytes) &&
+		common.BoundedBytes(m.GetProofAlphaX(), tss.MaxScalarBytes) &&
+		common.BoundedBytes(m.GetProofAlphaY(), tss.MaxScalarBytes) &&
+		common.BoundedBytes(m.GetProofT(), tss.MaxScalarBytes)
+}
+
+func (m *KGRound2Message2) RoundNumber() int {
+	return 2
 }
 
 func (m *KGRound2Message2) UnmarshalDeCommitment() []*big.Int {
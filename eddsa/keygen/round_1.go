@@ -37,7 +37,7 @@ func (round *round1) Start() *tss.Error {
 	Pi := round.PartyID()
 	i := Pi.Index
 
-	round.temp.ssidNonce = new(big.Int).SetUint64(0)
+	round.temp.ssidNonce = round.SSIDNonce()
 	ssid, err := round.getSSID()
 	if err != nil {
 		return round.WrapError(err)
@@ -49,7 +49,8 @@ func (round *round1) Start() *tss.Error {
 	round.temp.ui = ui
 
 	// 2. compute the vss shares
-	ids := round.Parties().IDs().Keys()
+	// the shares are evaluated at the share IDs of the parties, which are their keys unless set explicitly
+	ids := append([]*big.Int(nil), round.Params().ShareIDs()...)
 	vs, shares, err := vss.Create(round.EC(), round.Threshold(), ui, ids, round.Rand())
 	if err != nil {
 		return round.WrapError(err, Pi)
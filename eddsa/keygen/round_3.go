@@ -53,14 +53,18 @@ func (round *round3) Start() *tss.Error {
 	type vssOut struct {
 		unWrappedErr error
 		pjVs         vss.Vs
+		// the messages that the error is about, which are its evidence
+		msgs []tss.Message
 	}
 	chs := make([]chan vssOut, len(Ps))
 	for i := range chs {
 		if i == PIdx {
 			continue
 		}
-		chs[i] = make(chan vssOut)
+		// buffered so that the verifications never wait on the round
+		chs[i] = make(chan vssOut, 1)
 	}
+	exec := round.Executor()
 	for j := range Ps {
 		if j == PIdx {
 			continue
@@ -68,15 +72,17 @@ func (round *round3) Start() *tss.Error {
 		ContextJ := common.AppendBigIntToBytesSlice(round.temp.ssid, big.NewInt(int64(j)))
 
 		// 6-9.
-		go func(j int, ch chan<- vssOut) {
+		ch := chs[j]
+		exec.Go(func() {
 			// 4-10.
 			KGCj := round.temp.KGCs[j]
-			r2msg2 := round.temp.kgRound2Message2s[j].Content().(*KGRound2Message2)
+			cmtMsg, shareMsg, deCmtMsg := round.temp.kgRound1Messages[j], round.temp.kgRound2Message1s[j], round.temp.kgRound2Message2s[j]
+			r2msg2 := deCmtMsg.Content().(*KGRound2Message2)
 			KGDj := r2msg2.UnmarshalDeCommitment()
 			cmtDeCmt := commitments.HashCommitDecommit{C: KGCj, D: KGDj}
 			ok, flatPolyGs := cmtDeCmt.DeCommit()
 			if !ok || flatPolyGs == nil {
-				ch <- vssOut{errors.New("de-commitment verify failed"), nil}
+				ch <- vssOut{tss.Classify(errors.New("de-commitment verify failed"), tss.ErrCommitmentMismatch), nil, []tss.Message{cmtMsg, deCmtMsg}}
 				return
 			}
 
@@ -86,35 +92,35 @@ func (round *round3) Start() *tss.Error {
 			}
 
 			if err != nil {
-				ch <- vssOut{err, nil}
+				ch <- vssOut{err, nil, []tss.Message{deCmtMsg}}
 				return
 			}
 			proof, err := r2msg2.UnmarshalZKProof(round.Params().EC())
 			if err != nil {
-				ch <- vssOut{errors.New("failed to unmarshal schnorr proof"), nil}
+				ch <- vssOut{errors.New("failed to unmarshal schnorr proof"), nil, []tss.Message{deCmtMsg}}
 				return
 			}
-			ok = proof.Verify(ContextJ, PjVs[0])
+			ok = round.verifyProof("schnorr", func() bool { return proof.Verify(ContextJ, PjVs[0]) })
 			if !ok {
-				ch <- vssOut{errors.New("failed to prove schnorr proof"), nil}
+				ch <- vssOut{errors.New("failed to prove schnorr proof"), nil, []tss.Message{deCmtMsg}}
 				return
 			}
-			r2msg1 := round.temp.kgRound2Message1s[j].Content().(*KGRound2Message1)
+			r2msg1 := shareMsg.Content().(*KGRound2Message1)
 			PjShare := vss.Share{
 				Threshold: round.Threshold(),
-				ID:        round.PartyID().KeyInt(),
+				ID:        round.save.ShareID,
 				Share:     r2msg1.UnmarshalShare(),
 			}
 			if ok = PjShare.Verify(round.Params().EC(), round.Threshold(), PjVs); !ok {
-				ch <- vssOut{errors.New("vss verify failed"), nil}
+				ch <- vssOut{tss.Classify(errors.New("vss verify failed"), tss.ErrVSSFailure), nil, []tss.Message{shareMsg, cmtMsg, deCmtMsg}}
 				return
 			}
 			// (9) handled above
-			ch <- vssOut{nil, PjVs}
-		}(j, chs[j])
+			ch <- vssOut{nil, PjVs, nil}
+		})
 	}
 
-	// consume unbuffered channels (end the goroutines)
+	// consume the channels (wait for the verifications)
 	vssResults := make([]vssOut, len(Ps))
 	{
 		culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
@@ -130,12 +136,14 @@ func (round *round3) Start() *tss.Error {
 		}
 		var multiErr error
 		if len(culprits) > 0 {
+			var msgs []tss.Message
 			for _, vssResult := range vssResults {
 				if vssResult.unWrappedErr != nil {
 					multiErr = multierror.Append(multiErr, vssResult.unWrappedErr)
+					msgs = append(msgs, vssResult.msgs...)
 				}
 			}
-			return round.WrapError(multiErr, culprits...)
+			return round.WrapError(multiErr, culprits...).WithMessages(msgs...)
 		}
 	}
 	{
@@ -155,7 +163,7 @@ func (round *round3) Start() *tss.Error {
 			}
 		}
 		if len(culprits) > 0 {
-			return round.WrapError(errors.New("adding PjVs[c] to Vc[c] resulted in a point not on the curve"), culprits...)
+			return round.WrapError(tss.Classify(errors.New("adding PjVs[c] to Vc[c] resulted in a point not on the curve"), tss.ErrVSSFailure), culprits...)
 		}
 	}
 
@@ -167,7 +175,7 @@ func (round *round3) Start() *tss.Error {
 		bigXj := round.save.BigXj
 		for j := 0; j < round.PartyCount(); j++ {
 			Pj := round.Parties().IDs()[j]
-			kj := Pj.KeyInt()
+			kj := round.save.Ks[j]
 			BigXj := Vc[0]
 			z := new(big.Int).SetInt64(int64(1))
 			for c := 1; c <= round.Threshold(); c++ {
@@ -180,7 +188,7 @@ func (round *round3) Start() *tss.Error {
 			bigXj[j] = BigXj
 		}
 		if len(culprits) > 0 {
-			return round.WrapError(errors.New("adding Vc[c].ScalarMult(z) to BigXj resulted in a point not on the curve"), culprits...)
+			return round.WrapError(tss.Classify(errors.New("adding Vc[c].ScalarMult(z) to BigXj resulted in a point not on the curve"), tss.ErrVSSFailure), culprits...)
 		}
 		round.save.BigXj = bigXj
 	}
@@ -193,8 +201,9 @@ func (round *round3) Start() *tss.Error {
 	round.save.EDDSAPub = eddsaPubKey
 
 	// PRINT public key & private share
-	common.Logger.Debugf("%s public key: %x", round.PartyID(), eddsaPubKey)
+	round.logger().Debug("public key", "x", eddsaPubKey.X(), "y", eddsaPubKey.Y())
 
+	round.temp.zeroize()
 	round.end <- round.save
 	return nil
 }
@@ -212,3 +221,7 @@ func (round *round3) Update() (bool, *tss.Error) {
 func (round *round3) NextRound() tss.Round {
 	return nil // finished!
 }
+
+func (round *round3) IsFinal() bool {
+	return true
+}
@@ -7,7 +7,9 @@
 package keygen
 
 import (
+	"errors"
 	"math/big"
+	"time"
 
 	"github.com/SafeMPC/tss-lib/common"
 	"github.com/SafeMPC/tss-lib/tss"
@@ -77,6 +79,21 @@ func (round *base) WrapError(err error, culprits ...*tss.PartyID) *tss.Error {
 	return tss.NewError(err, TaskName, round.number, round.PartyID(), culprits...)
 }
 
+// State is captured in a snapshot of the party
+func (round *base) State() tss.RoundState {
+	return tss.RoundState{Number: round.number, Started: round.started, OK: [][]bool{append([]bool{}, round.ok...)}}
+}
+
+// Restore puts a round created by NextRound() back into the state captured in a snapshot
+func (round *base) Restore(state tss.RoundState) error {
+	if len(state.OK) != 1 || len(state.OK[0]) != len(round.ok) {
+		return errors.New("the round state does not match the number of parties")
+	}
+	round.number, round.started = state.Number, state.Started
+	copy(round.ok, state.OK[0])
+	return nil
+}
+
 // ----- //
 
 // `ok` tracks parties which have been verified by Update()
@@ -86,10 +103,27 @@ func (round *base) resetOK() {
 	}
 }
 
+// logger returns the party's Logger enriched with the task and round
+func (round *base) logger() tss.Logger {
+	return round.Logger().With("task", TaskName, "round", round.number)
+}
+
+// verifyProof runs and times the verification of a proof, reporting it to the party's Observer
+func (round *base) verifyProof(proof string, verify func() bool) bool {
+	start := time.Now()
+	ok := verify()
+	round.ObserveProof(TaskName, round.number, proof, start, ok)
+	return ok
+}
+
 // get ssid from local params
 func (round *base) getSSID() ([]byte, error) {
 	ssidList := []*big.Int{round.EC().Params().P, round.EC().Params().N, round.EC().Params().Gx, round.EC().Params().Gy} // ec curve
 	ssidList = append(ssidList, round.Parties().IDs().Keys()...)
+	// the share IDs, if they are not the parties' keys, which are hashed already
+	if round.CustomShareIDs() {
+		ssidList = append(ssidList, round.ShareIDs()...)
+	}
 	ssidList = append(ssidList, big.NewInt(int64(round.number))) // round number
 	ssidList = append(ssidList, round.temp.ssidNonce)
 	ssid := common.SHA512_256i(ssidList...).Bytes()
@@ -8,9 +8,13 @@ package keygen
 
 import (
 	"encoding/hex"
+	"errors"
+	"fmt"
 	"math/big"
 
+	"github.com/SafeMPC/tss-lib/common"
 	"github.com/SafeMPC/tss-lib/crypto"
+	"github.com/SafeMPC/tss-lib/crypto/vss"
 	"github.com/SafeMPC/tss-lib/tss"
 )
 
@@ -42,7 +46,15 @@ func NewLocalPartySaveData(partyCount int) (saveData LocalPartySaveData) {
 }
 
 // BuildLocalSaveDataSubset re-creates the LocalPartySaveData to contain data for only the list of signing parties.
-func BuildLocalSaveDataSubset(sourceData LocalPartySaveData, sortedIDs tss.SortedPartyIDs) LocalPartySaveData {
+// The parties are found by their share IDs, given in the order of sortedIDs if the key was dealt with explicit share IDs
+// (see tss.Parameters.SetShareIDs); otherwise they are found by their keys.
+func BuildLocalSaveDataSubset(sourceData LocalPartySaveData, sortedIDs tss.SortedPartyIDs, shareIDs ...*big.Int) LocalPartySaveData {
+	if len(shareIDs) == 0 {
+		shareIDs = sortedIDs.Keys()
+	}
+	if len(shareIDs) != sortedIDs.Len() {
+		panic("BuildLocalSaveDataSubset: the number of share IDs does not match the number of parties")
+	}
 	keysToIndices := make(map[string]int, len(sourceData.Ks))
 	for j, kj := range sourceData.Ks {
 		keysToIndices[hex.EncodeToString(kj.Bytes())] = j
@@ -50,8 +62,8 @@ func BuildLocalSaveDataSubset(sourceData LocalPartySaveData, sortedIDs tss.Sorte
 	newData := NewLocalPartySaveData(sortedIDs.Len())
 	newData.LocalSecrets = sourceData.LocalSecrets
 	newData.EDDSAPub = sourceData.EDDSAPub
-	for j, id := range sortedIDs {
-		savedIdx, ok := keysToIndices[hex.EncodeToString(id.Key)]
+	for j, shareID := range shareIDs {
+		savedIdx, ok := keysToIndices[hex.EncodeToString(shareID.Bytes())]
 		if !ok {
 			panic("BuildLocalSaveDataSubset: unable to find a signer party in the local save data")
 		}
@@ -60,3 +72,60 @@ func BuildLocalSaveDataSubset(sourceData LocalPartySaveData, sortedIDs tss.Sorte
 	}
 	return newData
 }
+
+// VerifySaveDataSet checks that the save data of the parties of one key are consistent with each other: every party
+// has the same Ks, BigXj and EDDSAPub; any threshold+1 of the BigXj interpolate to EDDSAPub; and the secret share of
+// each party matches its BigXj.
+// The set may hold the save data of only some of the parties, such as those that one host keeps; a set that must be
+// complete is checked with VerifyCompleteSaveDataSet.
+func VerifySaveDataSet(threshold int, saves []LocalPartySaveData) error {
+	if len(saves) == 0 {
+		return errors.New("there is no save data to verify")
+	}
+	first := saves[0]
+	if first.EDDSAPub == nil {
+		return errors.New("the save data has no public key")
+	}
+	ec := tss.Edwards()
+	if len(first.BigXj) != len(first.Ks) {
+		return errors.New("the save data does not have the public data of every party")
+	}
+	if err := vss.CheckPublicShares(ec, threshold, first.Ks, first.BigXj, first.EDDSAPub); err != nil {
+		return err
+	}
+	seen := make(map[int]bool, len(saves))
+	for i, save := range saves {
+		switch {
+		case !common.EqualInts(save.Ks, first.Ks):
+			return fmt.Errorf("the save data %d differs from the save data 0: the Ks differ", i)
+		case !crypto.EqualECPoints(save.BigXj, first.BigXj):
+			return fmt.Errorf("the save data %d differs from the save data 0: the BigXj differ", i)
+		case !save.EDDSAPub.Equals(first.EDDSAPub):
+			return fmt.Errorf("the save data %d differs from the save data 0: the public keys differ", i)
+		}
+		j := common.IndexOfInt(save.Ks, save.ShareID)
+		if j < 0 {
+			return fmt.Errorf("the share ID of the save data %d is not one of the Ks", i)
+		}
+		if seen[j] {
+			return fmt.Errorf("the save data %d is of party %d, as is another save data", i, j)
+		}
+		seen[j] = true
+		if save.Xi == nil || !crypto.ScalarBaseMult(ec, save.Xi).Equals(save.BigXj[j]) {
+			return fmt.Errorf("the secret share of party %d does not match its BigXj", j)
+		}
+	}
+	return nil
+}
+
+// VerifyCompleteSaveDataSet checks the save data as VerifySaveDataSet does, and that they are those of every party of
+// the key
+func VerifyCompleteSaveDataSet(threshold int, saves []LocalPartySaveData) error {
+	if err := VerifySaveDataSet(threshold, saves); err != nil {
+		return err
+	}
+	if n := len(saves[0].Ks); len(saves) != n {
+		return fmt.Errorf("the set holds the save data of %d of the %d parties", len(saves), n)
+	}
+	return nil
+}
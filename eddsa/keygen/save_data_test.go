@@ -0,0 +1,96 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SafeMPC/tss-lib/crypto"
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+func TestVerifySaveDataSet(t *testing.T) {
+	saves, _, err := LoadKeygenTestFixtures(testParticipants)
+	if err != nil {
+		t.Skip("the test fixtures were not found; run the keygen tests first")
+	}
+	assert.NoError(t, VerifySaveDataSet(testThreshold, saves))
+	assert.NoError(t, VerifyCompleteSaveDataSet(testThreshold, saves))
+	assert.NoError(t, VerifySaveDataSet(testThreshold, saves[:testThreshold+1]), "a subset of the parties is consistent")
+	assert.Error(t, VerifyCompleteSaveDataSet(testThreshold, saves[:testThreshold+1]), "a subset of the parties is not complete")
+	assert.Error(t, VerifySaveDataSet(testThreshold-1, saves), "the shares are of a higher degree")
+
+	tampered := append([]LocalPartySaveData(nil), saves...)
+	tampered[1].Xi = new(big.Int).Add(saves[1].Xi, big.NewInt(1))
+	assert.Error(t, VerifySaveDataSet(testThreshold, tampered), "a secret share that does not match its BigXj")
+
+	tampered = append([]LocalPartySaveData(nil), saves...)
+	tampered[2].EDDSAPub = crypto.ScalarBaseMult(tss.Edwards(), big.NewInt(1))
+	assert.Error(t, VerifySaveDataSet(testThreshold, tampered), "a party that has another public key")
+}
+
+func TestSaveDataStorage(t *testing.T) {
+	saves, pIDs, err := LoadKeygenTestFixtures(testParticipants)
+	if err != nil {
+		t.Skip("the test fixtures were not found; run the keygen tests first")
+	}
+	for i := range saves {
+		bz, err := os.ReadFile(makeTestFixtureFilePath(i))
+		if !assert.NoError(t, err) {
+			return
+		}
+		// the conversion from the JSON and back is lossless
+		stored, err := ConvertJSONSaveData(bz, testThreshold, pIDs)
+		if !assert.NoError(t, err) {
+			return
+		}
+		converted, err := ConvertSaveDataToJSON(stored)
+		assert.NoError(t, err)
+		expected, err := json.Marshal(saves[i])
+		assert.NoError(t, err)
+		assert.Equal(t, string(expected), string(converted))
+
+		save, pb, err := UnmarshalSaveData(stored)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, saves[i], save)
+		assert.Equal(t, uint32(testThreshold), pb.GetThreshold())
+		assert.Equal(t, pIDs.Keys(), pb.PartyIDs().Keys())
+	}
+
+	// a zero integer, such as the Xi of an old committee after a resharing, is kept apart from an unset one
+	zeroed := saves[0]
+	zeroed.Xi = big.NewInt(0)
+	bz, err := MarshalSaveData(zeroed, testThreshold, nil)
+	if assert.NoError(t, err) {
+		save, _, err := UnmarshalSaveData(bz)
+		if assert.NoError(t, err) && assert.NotNil(t, save.Xi, "a zero Xi must not be lost") {
+			assert.Zero(t, save.Xi.Sign())
+		}
+	}
+	zeroed.Xi = nil
+	bz, err = MarshalSaveData(zeroed, testThreshold, nil)
+	if assert.NoError(t, err) {
+		save, _, err := UnmarshalSaveData(bz)
+		assert.NoError(t, err)
+		assert.Nil(t, save.Xi)
+	}
+
+	pb, err := NewPartySaveData(saves[0], testThreshold, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	pb.Version = SaveDataVersion + 1
+	_, err = pb.SaveData()
+	assert.Error(t, err, "save data of a newer version must be rejected")
+}
@@ -0,0 +1,25 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"fmt"
+
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+func init() {
+	tss.RegisterProtocol(tss.ProtocolEdDSAKeygen, newSessionParty)
+}
+
+// newSessionParty creates the party of a tss.ProtocolEdDSAKeygen session, which takes no input
+func newSessionParty(session *tss.Session, input any) (tss.Party, error) {
+	if input != nil {
+		return nil, fmt.Errorf("%s: expected no input, got %T", tss.ProtocolEdDSAKeygen, input)
+	}
+	return NewLocalParty(session.Params(), session.Out(), tss.SessionEnd[*LocalPartySaveData](session)), nil
+}
@@ -0,0 +1,85 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"encoding/json"
+	"math/big"
+
+	cmt "github.com/SafeMPC/tss-lib/crypto/commitments"
+	"github.com/SafeMPC/tss-lib/crypto/vss"
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+// snapshotData is the state of an in-progress keygen that is encrypted into a snapshot
+type snapshotData struct {
+	Data          LocalPartySaveData
+	UI            *big.Int
+	KGCs          []cmt.HashCommitment
+	Vs            vss.Vs
+	Shares        vss.Shares
+	DeCommitPolyG cmt.HashDeCommitment
+	SSID          []byte
+	SSIDNonce     *big.Int
+}
+
+// Snapshot captures the state of the party, including its secrets, so that it can be resumed with RestoreLocalParty
+// after a restart. The snapshot is encrypted under the parameters' snapshot key.
+func (p *LocalParty) Snapshot() ([]byte, error) {
+	return tss.BaseSnapshot(p, TaskName, func() ([]tss.ParsedMessage, []byte, error) {
+		secrets, err := json.Marshal(&snapshotData{
+			Data:          p.data,
+			UI:            p.temp.ui,
+			KGCs:          p.temp.KGCs,
+			Vs:            p.temp.vs,
+			Shares:        p.temp.shares,
+			DeCommitPolyG: p.temp.deCommitPolyG,
+			SSID:          p.temp.ssid,
+			SSIDNonce:     p.temp.ssidNonce,
+		})
+		return p.temp.messages(), secrets, err
+	})
+}
+
+// RestoreLocalParty recreates a party from its Snapshot. The party continues in the round that it was in without
+// starting it again, so no fresh randomness is drawn; it must not be started.
+func RestoreLocalParty(
+	params *tss.Parameters,
+	snapshot []byte,
+	out chan<- tss.Message,
+	end chan<- *LocalPartySaveData,
+) (tss.Party, error) {
+	return tss.BaseRestore(params, snapshot, TaskName, func(secrets []byte) (tss.Party, error) {
+		data := new(snapshotData)
+		if err := json.Unmarshal(secrets, data); err != nil {
+			return nil, err
+		}
+		p := NewLocalParty(params, out, end).(*LocalParty)
+		p.data = data.Data
+		p.temp.ui = data.UI
+		p.temp.KGCs = data.KGCs
+		p.temp.vs = data.Vs
+		p.temp.shares = data.Shares
+		p.temp.deCommitPolyG = data.DeCommitPolyG
+		p.temp.ssid = data.SSID
+		p.temp.ssidNonce = data.SSIDNonce
+		return p, nil
+	})
+}
+
+func (store *localMessageStore) messages() []tss.ParsedMessage {
+	var msgs []tss.ParsedMessage
+	for _, stored := range [][]tss.ParsedMessage{
+		store.kgRound1Messages,
+		store.kgRound2Message1s,
+		store.kgRound2Message2s,
+		store.kgRound3Messages,
+	} {
+		msgs = append(msgs, stored...)
+	}
+	return msgs
+}
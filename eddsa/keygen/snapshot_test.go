@@ -0,0 +1,100 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SafeMPC/tss-lib/test"
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+func TestSnapshotRestore(t *testing.T) {
+	setUp("info")
+
+	pIDs := tss.GenerateTestPartyIDs(testParticipants)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	snapshotKey := make([]byte, 32)
+	_, _ = rand.Read(snapshotKey)
+	newParams := func(pID *tss.PartyID) *tss.Parameters {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pID, len(pIDs), testThreshold)
+		params.SetSnapshotKey(snapshotKey)
+		return params
+	}
+
+	errCh := make(chan *tss.Error, len(pIDs))
+	outCh := make(chan tss.Message, len(pIDs)*len(pIDs)*2)
+	endCh := make(chan *LocalPartySaveData, len(pIDs))
+	parties := make([]*LocalParty, len(pIDs))
+	for i, pID := range pIDs {
+		parties[i] = NewLocalParty(newParams(pID), outCh, endCh).(*LocalParty)
+		if err := parties[i].Start(); err != nil {
+			assert.FailNow(t, err.Error())
+		}
+	}
+
+	restored := false
+	saves := make([]*LocalPartySaveData, 0, len(pIDs))
+	for len(saves) < len(pIDs) {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case save := <-endCh:
+			saves = append(saves, save)
+		case msg := <-outCh:
+			if dest := msg.GetTo(); dest == nil {
+				for _, P := range parties {
+					test.SharedPartyUpdater(P, msg, errCh)
+				}
+			} else {
+				test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+			}
+			if restored || parties[0].temp.kgRound2Message2s[1] == nil {
+				continue
+			}
+			// restart party 0 part way through round 2
+			snapshot, err := parties[0].Snapshot()
+			if !assert.NoError(t, err) {
+				return
+			}
+			ui := parties[0].temp.ui
+			P, err := RestoreLocalParty(newParams(pIDs[0]), snapshot, outCh, endCh)
+			if !assert.NoError(t, err) {
+				return
+			}
+			parties[0] = P.(*LocalParty)
+			assert.Equal(t, 2, parties[0].temp.kgRound2Message2s[1].Content().(*KGRound2Message2).RoundNumber())
+			assert.Equal(t, 0, ui.Cmp(parties[0].temp.ui), "the restored party must keep its secrets")
+			assert.NotNil(t, parties[0].Start(), "a restored party must not start again")
+			restored = true
+		}
+	}
+	assert.True(t, restored)
+	for _, save := range saves {
+		assert.True(t, save.EDDSAPub.Equals(saves[0].EDDSAPub))
+	}
+
+	// a snapshot can only be restored by its party with its key
+	_, err := NewLocalParty(newParams(pIDs[1]), outCh, endCh).(*LocalParty).Snapshot()
+	assert.Error(t, err, "a party that has not started has nothing to snapshot")
+	params := newParams(pIDs[1])
+	fresh := NewLocalParty(params, outCh, endCh).(*LocalParty)
+	if !assert.Nil(t, fresh.Start()) {
+		return
+	}
+	snapshot, err := fresh.Snapshot()
+	assert.NoError(t, err)
+	wrongKey := newParams(pIDs[1])
+	wrongKey.SetSnapshotKey(make([]byte, 32))
+	_, err = RestoreLocalParty(wrongKey, snapshot, outCh, endCh)
+	assert.Error(t, err)
+	_, err = RestoreLocalParty(newParams(pIDs[2]), snapshot, outCh, endCh)
+	assert.Error(t, err, "a snapshot cannot be restored by another party")
+}
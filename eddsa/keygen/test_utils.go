@@ -58,6 +58,9 @@ func LoadKeygenTestFixtures(qty int, optionalStart ...int) ([]LocalPartySaveData
 		key.EDDSAPub.SetCurve(tss.Edwards())
 		keys = append(keys, key)
 	}
+	if err := VerifySaveDataSet(TestThreshold, keys); err != nil {
+		return nil, nil, errors.Wrap(err, "the test fixtures are inconsistent")
+	}
 	partyIDs := make(tss.UnSortedPartyIDs, len(keys))
 	for i, key := range keys {
 		pMoniker := fmt.Sprintf("%d", i+start+1)
@@ -96,6 +99,9 @@ func LoadKeygenTestFixturesRandomSet(qty, fixtureCount int) ([]LocalPartySaveDat
 		key.EDDSAPub.SetCurve(tss.Edwards())
 		keys = append(keys, key)
 	}
+	if err := VerifySaveDataSet(TestThreshold, keys); err != nil {
+		return nil, nil, errors.Wrap(err, "the test fixtures are inconsistent")
+	}
 	partyIDs := make(tss.UnSortedPartyIDs, len(keys))
 	j := 0
 	for i := range plucked {
@@ -72,10 +72,10 @@ func NewLocalParty(
 	oldPartyCount := len(params.OldParties().IDs())
 	subset := key
 	if params.IsOldCommittee() {
-		subset = keygen.BuildLocalSaveDataSubset(key, params.OldParties().IDs())
+		subset = keygen.BuildLocalSaveDataSubset(key, params.OldParties().IDs(), params.ShareIDs()...)
 	}
 	p := &LocalParty{
-		BaseParty: new(tss.BaseParty),
+		BaseParty: tss.NewBaseParty(out),
 		params:    params,
 		temp:      localTempData{},
 		input:     subset,
@@ -105,10 +105,18 @@ func (p *LocalParty) Update(msg tss.ParsedMessage) (ok bool, err *tss.Error) {
 	return tss.BaseUpdate(p, msg, TaskName)
 }
 
+// Abort stops the party, overwrites the secrets in its temp data and drops the messages that it received
+func (p *LocalParty) Abort(reason error) *tss.Error {
+	return tss.BaseAbort(p, TaskName, reason, func() {
+		p.temp.zeroize()
+		p.temp.localMessageStore = localMessageStore{}
+	})
+}
+
 func (p *LocalParty) UpdateFromBytes(wireBytes []byte, from *tss.PartyID, isBroadcast bool) (bool, *tss.Error) {
-	msg, err := tss.ParseWireMessage(wireBytes, from, isBroadcast)
+	msg, err := p.params.ParseWireMessage(wireBytes, from, isBroadcast)
 	if err != nil {
-		return false, p.WrapError(err)
+		return false, tss.WrapParseError(p, err, wireBytes, from, isBroadcast)
 	}
 	return p.Update(msg)
 }
@@ -126,8 +134,8 @@ func (p *LocalParty) ValidateMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 		maxFromIdx = len(p.params.OldParties().IDs()) - 1
 	}
 	if maxFromIdx < msg.GetFrom().Index {
-		return false, p.WrapError(fmt.Errorf("received msg with a sender index too great (%d <= %d)",
-			maxFromIdx, msg.GetFrom().Index), msg.GetFrom())
+		return false, p.WrapError(tss.Classify(fmt.Errorf("received msg with a sender index too great (%d <= %d)",
+			maxFromIdx, msg.GetFrom().Index), tss.ErrBadMessage), msg.GetFrom()).WithMessages(msg)
 	}
 	return true, nil
 }
@@ -140,23 +148,24 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	fromPIdx := msg.GetFrom().Index
 
 	// switch/case is necessary to store any messages beyond current round
-	// this does not handle message replays. we expect the caller to apply replay and spoofing protection.
+	// a repeated message from the same sender is ignored if identical and rejected as equivocation otherwise.
+	var slot *tss.ParsedMessage
 	switch msg.Content().(type) {
 	case *DGRound1Message:
-		p.temp.dgRound1Messages[fromPIdx] = msg
+		slot = &p.temp.dgRound1Messages[fromPIdx]
 	case *DGRound2Message:
-		p.temp.dgRound2Messages[fromPIdx] = msg
+		slot = &p.temp.dgRound2Messages[fromPIdx]
 	case *DGRound3Message1:
-		p.temp.dgRound3Message1s[fromPIdx] = msg
+		slot = &p.temp.dgRound3Message1s[fromPIdx]
 	case *DGRound3Message2:
-		p.temp.dgRound3Message2s[fromPIdx] = msg
+		slot = &p.temp.dgRound3Message2s[fromPIdx]
 	case *DGRound4Message:
-		p.temp.dgRound4Messages[fromPIdx] = msg
+		slot = &p.temp.dgRound4Messages[fromPIdx]
 	default: // unrecognised message, just ignore!
-		common.Logger.Warningf("unrecognised message ignored: %v", msg)
+		p.params.Logger().Warn("unrecognised message ignored", "task", TaskName, "msg", msg.String())
 		return false, nil
 	}
-	return true, nil
+	return tss.StoreMessageOnce(p, slot, msg)
 }
 
 func (p *LocalParty) PartyID() *tss.PartyID {
@@ -166,3 +175,11 @@ func (p *LocalParty) PartyID() *tss.PartyID {
 func (p *LocalParty) String() string {
 	return fmt.Sprintf("id: %s, %s", p.PartyID(), p.BaseParty.String())
 }
+
+// zeroize overwrites the secrets in the temp data; the party does so itself once it has output its result
+func (temp *localTempData) zeroize() {
+	common.Zeroize(temp.newXi)
+	for _, share := range temp.NewShares {
+		common.Zeroize(share.Share)
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/SafeMPC/tss-lib/eddsa/signing"
 	"github.com/SafeMPC/tss-lib/test"
 	"github.com/SafeMPC/tss-lib/tss"
+	"github.com/SafeMPC/tss-lib/tss/transport"
 )
 
 const (
@@ -33,12 +34,19 @@ func setUp(level string) {
 	if err := log.SetLogLevel("tss-lib", level); err != nil {
 		panic(err)
 	}
-
-	// only for test
-	tss.SetCurve(tss.Edwards())
 }
 
 func TestE2EConcurrent(t *testing.T) {
+	testE2EConcurrent(t, nil)
+}
+
+func TestE2EConcurrentWithShareIDs(t *testing.T) {
+	testE2EConcurrent(t, tss.SequentialShareIDs(testParticipants))
+}
+
+// testE2EConcurrent reshares a key to a new committee, whose shares are evaluated at newShareIDs if they are given, and
+// signs with it
+func testE2EConcurrent(t *testing.T, newShareIDs []*big.Int) {
 	setUp("info")
 
 	threshold, newThreshold := testThreshold, testThreshold
@@ -64,21 +72,31 @@ func TestE2EConcurrent(t *testing.T) {
 	outCh := make(chan tss.Message, bothCommitteesPax)
 	endCh := make(chan *keygen.LocalPartySaveData, bothCommitteesPax)
 
-	updater := test.SharedPartyUpdater
+	hub := transport.NewHub()
+	defer hub.Close()
+	updater := test.SharedTransportUpdater
 
 	// init the old parties first
 	for j, pID := range oldPIDs {
 		params := tss.NewReSharingParameters(tss.Edwards(), oldP2PCtx, newP2PCtx, pID, testParticipants, threshold, newPCount, newThreshold)
+		params.SetNewShareIDs(newShareIDs)
 		P := NewLocalParty(params, oldKeys[j], outCh, endCh).(*LocalParty) // discard old key data
 		oldCommittee = append(oldCommittee, P)
+		conn, err := hub.ConnectOldCommittee(P.PartyID())
+		assert.NoError(t, err)
+		go updater(P, conn, errCh)
 	}
 
 	// init the new parties
 	for _, pID := range newPIDs {
 		params := tss.NewReSharingParameters(tss.Edwards(), oldP2PCtx, newP2PCtx, pID, testParticipants, threshold, newPCount, newThreshold)
+		params.SetNewShareIDs(newShareIDs)
 		save := keygen.NewLocalPartySaveData(newPCount)
 		P := NewLocalParty(params, save, outCh, endCh).(*LocalParty)
 		newCommittee = append(newCommittee, P)
+		conn, err := hub.Connect(P.PartyID())
+		assert.NoError(t, err)
+		go updater(P, conn, errCh)
 	}
 
 	// start the new parties; they will wait for messages
@@ -109,19 +127,8 @@ func TestE2EConcurrent(t *testing.T) {
 			return
 
 		case msg := <-outCh:
-			dest := msg.GetTo()
-			if dest == nil {
-				t.Fatal("did not expect a msg to have a nil destination during resharing")
-			}
-			if msg.IsToOldCommittee() || msg.IsToOldAndNewCommittees() {
-				for _, destP := range dest[:len(oldCommittee)] {
-					go updater(oldCommittee[destP.Index], msg, errCh)
-				}
-			}
-			if !msg.IsToOldCommittee() || msg.IsToOldAndNewCommittees() {
-				for _, destP := range dest {
-					go updater(newCommittee[destP.Index], msg, errCh)
-				}
+			if err := hub.Send(msg); err != nil {
+				assert.FailNow(t, err.Error())
 			}
 
 		case save := <-endCh:
@@ -145,6 +152,9 @@ func TestE2EConcurrent(t *testing.T) {
 					gXj := crypto.ScalarBaseMult(tss.Edwards(), xj)
 					BigXj := key.BigXj[j]
 					assert.True(t, BigXj.Equals(gXj), "ensure BigX_j == g^x_j")
+					if newShareIDs != nil {
+						assert.Equal(t, newShareIDs, key.Ks, "the shares must be dealt at the share IDs")
+					}
 				}
 
 				// more verification of signing is implemented within local_party_test.go of keygen package
@@ -163,10 +173,17 @@ signing:
 	signOutCh := make(chan tss.Message, len(signPIDs))
 	signEndCh := make(chan *common.SignatureData, len(signPIDs))
 
+	signHub := transport.NewHub()
+	defer signHub.Close()
+
 	for j, signPID := range signPIDs {
 		params := tss.NewParameters(tss.Edwards(), signP2pCtx, signPID, len(signPIDs), newThreshold)
+		params.SetShareIDs(newShareIDs)
 		P := signing.NewLocalParty(big.NewInt(42), params, signKeys[j], signOutCh, signEndCh).(*signing.LocalParty)
 		signParties = append(signParties, P)
+		conn, err := signHub.Connect(P.PartyID())
+		assert.NoError(t, err)
+		go updater(P, conn, signErrCh)
 		go func(P *signing.LocalParty) {
 			if err := P.Start(); err != nil {
 				signErrCh <- err
@@ -183,19 +200,8 @@ signing:
 			return
 
 		case msg := <-signOutCh:
-			dest := msg.GetTo()
-			if dest == nil {
-				for _, P := range signParties {
-					if P.PartyID().Index == msg.GetFrom().Index {
-						continue
-					}
-					go updater(P, msg, signErrCh)
-				}
-			} else {
-				if dest[0].Index == msg.GetFrom().Index {
-					t.Fatalf("party %d tried to send a message to itself (%d)", dest[0].Index, msg.GetFrom().Index)
-				}
-				go updater(signParties[dest[0].Index], msg, signErrCh)
+			if err := signHub.Send(msg); err != nil {
+				assert.FailNow(t, err.Error())
 			}
 
 		case signData := <-signEndCh:
@@ -55,9 +55,13 @@ func NewDGRound1Message(
 
 func (m *DGRound1Message) ValidateBasic() bool {
 	return m != nil &&
-		common.NonEmptyBytes(m.EddsaPubX) &&
-		common.NonEmptyBytes(m.EddsaPubY) &&
-		common.NonEmptyBytes(m.VCommitment)
+		common.BoundedBytes(m.EddsaPubX, tss.MaxScalarBytes) &&
+		common.BoundedBytes(m.EddsaPubY, tss.MaxScalarBytes) &&
+		common.BoundedBytes(m.VCommitment, tss.MaxHashBytes)
+}
+
+func (m *DGRound1Message) RoundNumber() int {
+	return 1
 }
 
 func (m *DGRound1Message) UnmarshalEDDSAPub(ec elliptic.Curve) (*crypto.ECPoint, error) {
@@ -92,6 +96,10 @@ func (m *DGRound2Message) ValidateBasic() bool {
 	return true
 }
 
+func (m *DGRound2Message) RoundNumber() int {
+	return 2
+}
+
 // ----- //
 
 func NewDGRound3Message1(
@@ -114,7 +122,11 @@ func NewDGRound3Message1(
 
 func (m *DGRound3Message1) ValidateBasic() bool {
 	return m != nil &&
-		common.NonEmptyBytes(m.Share)
+		common.BoundedBytes(m.Share, tss.MaxScalarBytes)
+}
+
+func (m *DGRound3Message1) RoundNumber() int {
+	return 3
 }
 
 // ----- //
@@ -140,7 +152,11 @@ func NewDGRound3Message2(
 
 func (m *DGRound3Message2) ValidateBasic() bool {
 	return m != nil &&
-		common.NonEmptyMultiBytes(m.VDecommitment)
+		common.BoundedMultiBytes(m.VDecommitment, tss.MaxScalarBytes)
+}
+
+func (m *DGRound3Message2) RoundNumber() int {
+	return 3
 }
 
 func (m *DGRound3Message2) UnmarshalVDeCommitment() cmt.HashDeCommitment {
@@ -168,3 +184,7 @@ func NewDGRound4Message(
 func (m *DGRound4Message) ValidateBasic() bool {
 	return true
 }
+
+func (m *DGRound4Message) RoundNumber() int {
+	return 4
+}
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/SafeMPC/tss-lib/common"
 	"github.com/SafeMPC/tss-lib/crypto"
 	"github.com/SafeMPC/tss-lib/crypto/commitments"
 	"github.com/SafeMPC/tss-lib/crypto/vss"
@@ -47,11 +48,13 @@ func (round *round1) Start() *tss.Error {
 	if round.Threshold()+1 > len(ks) {
 		return round.WrapError(fmt.Errorf("t+1=%d is not satisfied by the key count of %d", round.Threshold()+1, len(ks)), round.PartyID())
 	}
-	newKs := round.NewParties().IDs().Keys()
+	newKs := round.NewShareIDs()
 	wi := signing.PrepareForSigning(round.Params().EC(), i, len(round.OldParties().IDs()), xi, ks)
 
 	// 2.
 	vi, shares, err := vss.Create(round.Params().EC(), round.NewThreshold(), wi, newKs, round.Rand())
+	// w_i is needed only to deal the new shares
+	common.Zeroize(wi)
 	if err != nil {
 		return round.WrapError(err, round.PartyID())
 	}
@@ -102,20 +105,16 @@ func (round *round1) Update() (bool, *tss.Error) {
 		}
 		round.oldOK[j] = true
 
-		if round.temp.dgRound1Messages[0] == nil {
-			ret = false
-			continue
-		}
 		// save the eddsa pub received from the old committee
-		r1msg := round.temp.dgRound1Messages[0].Content().(*DGRound1Message)
+		r1msg := msg.Content().(*DGRound1Message)
 		candidate, err := r1msg.UnmarshalEDDSAPub(round.Params().EC())
 		if err != nil {
-			return false, round.WrapError(errors.New("unable to unmarshal the eddsa pub key"), msg.GetFrom())
+			return false, round.WrapError(tss.Classify(errors.New("unable to unmarshal the eddsa pub key"), tss.ErrBadMessage), msg.GetFrom()).WithMessages(msg)
 		}
 		if round.save.EDDSAPub != nil &&
 			!candidate.Equals(round.save.EDDSAPub) {
 			// uh oh - anomaly!
-			return false, round.WrapError(errors.New("eddsa pub key did not match what we received previously"), msg.GetFrom())
+			return false, round.WrapError(errors.New("eddsa pub key did not match what we received previously"), msg.GetFrom()).WithMessages(msg)
 		}
 		round.save.EDDSAPub = candidate
 	}
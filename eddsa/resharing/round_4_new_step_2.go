@@ -53,11 +53,12 @@ func (round *round4) Start() *tss.Error {
 		ok, flatVs := vCmtDeCmt.DeCommit()
 		if !ok || len(flatVs) != (round.NewThreshold()+1)*2 { // they're points so * 2
 			// TODO collect culprits and return a list of them as per convention
-			return round.WrapError(errors.New("de-commitment of v_j0..v_jt failed"), round.Parties().IDs()[j])
+			return round.WrapError(tss.Classify(errors.New("de-commitment of v_j0..v_jt failed"), tss.ErrCommitmentMismatch), round.Parties().IDs()[j]).
+				WithMessages(round.temp.dgRound1Messages[j], round.temp.dgRound3Message2s[j])
 		}
 		vj, err := crypto.UnFlattenECPoints(round.Params().EC(), flatVs)
 		if err != nil {
-			return round.WrapError(err, round.Parties().IDs()[j])
+			return round.WrapError(err, round.Parties().IDs()[j]).WithMessages(round.temp.dgRound3Message2s[j])
 		}
 
 		for i, v := range vj {
@@ -69,11 +70,12 @@ func (round *round4) Start() *tss.Error {
 		r3msg1 := round.temp.dgRound3Message1s[j].Content().(*DGRound3Message1)
 		sharej := &vss.Share{
 			Threshold: round.NewThreshold(),
-			ID:        round.PartyID().KeyInt(),
+			ID:        round.NewShareIDs()[round.PartyID().Index],
 			Share:     new(big.Int).SetBytes(r3msg1.Share),
 		}
 		if ok := sharej.Verify(round.Params().EC(), round.NewThreshold(), vj); !ok {
-			return round.WrapError(errors.New("share from old committee did not pass Verify()"), round.Parties().IDs()[j])
+			return round.WrapError(tss.Classify(errors.New("share from old committee did not pass Verify()"), tss.ErrVSSFailure), round.Parties().IDs()[j]).
+				WithMessages(round.temp.dgRound3Message1s[j], round.temp.dgRound1Messages[j], round.temp.dgRound3Message2s[j])
 		}
 
 		newXi = new(big.Int).Add(newXi, sharej.Share)
@@ -94,7 +96,7 @@ func (round *round4) Start() *tss.Error {
 
 	// 13-15.
 	if !Vc[0].Equals(round.save.EDDSAPub) {
-		return round.WrapError(errors.New("assertion failed: V_0 != y"), round.PartyID())
+		return round.WrapError(tss.Classify(errors.New("assertion failed: V_0 != y"), tss.ErrVSSFailure), round.PartyID())
 	}
 
 	// 16-20.
@@ -103,7 +105,7 @@ func (round *round4) Start() *tss.Error {
 	culprits := make([]*tss.PartyID, 0, round.NewPartyCount()) // who caused the error(s)
 	for j := 0; j < round.NewPartyCount(); j++ {
 		Pj := round.NewParties().IDs()[j]
-		kj := Pj.KeyInt()
+		kj := round.NewShareIDs()[j]
 		newBigXj := Vc[0]
 		newKs = append(newKs, kj)
 		z := new(big.Int).SetInt64(int64(1))
@@ -25,7 +25,7 @@ func (round *round5) Start() *tss.Error {
 	if round.IsNewCommittee() {
 		// for this P: SAVE data
 		round.save.BigXj = round.temp.newBigXjs
-		round.save.ShareID = round.PartyID().KeyInt()
+		round.save.ShareID = round.NewShareIDs()[round.PartyID().Index]
 		round.save.Xi = round.temp.newXi
 		round.save.Ks = round.temp.newKs
 
@@ -33,6 +33,9 @@ func (round *round5) Start() *tss.Error {
 		round.input.Xi.SetInt64(0)
 	}
 
+	// the new share is output in the save data
+	round.temp.newXi = nil
+	round.temp.zeroize()
 	round.end <- round.save
 	return nil
 }
@@ -48,3 +51,7 @@ func (round *round5) Update() (bool, *tss.Error) {
 func (round *round5) NextRound() tss.Round {
 	return nil // both committees are finished!
 }
+
+func (round *round5) IsFinal() bool {
+	return true
+}
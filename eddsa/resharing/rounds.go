@@ -7,6 +7,7 @@
 package resharing
 
 import (
+	"errors"
 	"github.com/SafeMPC/tss-lib/eddsa/keygen"
 	"github.com/SafeMPC/tss-lib/tss"
 )
@@ -108,6 +109,26 @@ func (round *base) WrapError(err error, culprits ...*tss.PartyID) *tss.Error {
 	return tss.NewError(err, TaskName, round.number, round.PartyID(), culprits...)
 }
 
+// State is captured in a snapshot of the party
+func (round *base) State() tss.RoundState {
+	return tss.RoundState{
+		Number:  round.number,
+		Started: round.started,
+		OK:      [][]bool{append([]bool{}, round.oldOK...), append([]bool{}, round.newOK...)},
+	}
+}
+
+// Restore puts a round created by NextRound() back into the state captured in a snapshot
+func (round *base) Restore(state tss.RoundState) error {
+	if len(state.OK) != 2 || len(state.OK[0]) != len(round.oldOK) || len(state.OK[1]) != len(round.newOK) {
+		return errors.New("the round state does not match the number of parties")
+	}
+	round.number, round.started = state.Number, state.Started
+	copy(round.oldOK, state.OK[0])
+	copy(round.newOK, state.OK[1])
+	return nil
+}
+
 // ----- //
 
 // `oldOK` tracks parties which have been verified by Update()
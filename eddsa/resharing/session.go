@@ -0,0 +1,43 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package resharing
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/SafeMPC/tss-lib/eddsa/keygen"
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+// SessionInput is the input of a tss.ProtocolEdDSAResharing session of a tss.SessionManager. The session must be
+// created with the Parameters that Params embeds.
+type SessionInput struct {
+	Params *tss.ReSharingParameters
+	// Key is the key of a member of the old committee, or new save data for a member of the new committee
+	Key keygen.LocalPartySaveData
+}
+
+func init() {
+	tss.RegisterProtocol(tss.ProtocolEdDSAResharing, newSessionParty)
+}
+
+func newSessionParty(session *tss.Session, input any) (tss.Party, error) {
+	var in SessionInput
+	switch input := input.(type) {
+	case SessionInput:
+		in = input
+	case *SessionInput:
+		in = *input
+	default:
+		return nil, fmt.Errorf("%s: expected a resharing.SessionInput, got %T", tss.ProtocolEdDSAResharing, input)
+	}
+	if in.Params == nil || in.Params.Parameters != session.Params() {
+		return nil, errors.New("the resharing parameters must embed the parameters of the session")
+	}
+	return NewLocalParty(in.Params, in.Key, session.Out(), tss.SessionEnd[*keygen.LocalPartySaveData](session)), nil
+}
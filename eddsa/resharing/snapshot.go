@@ -0,0 +1,87 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package resharing
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/SafeMPC/tss-lib/crypto"
+	cmt "github.com/SafeMPC/tss-lib/crypto/commitments"
+	"github.com/SafeMPC/tss-lib/crypto/vss"
+	"github.com/SafeMPC/tss-lib/eddsa/keygen"
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+// snapshotData is the state of an in-progress re-sharing that is encrypted into a snapshot
+type snapshotData struct {
+	Input, Save keygen.LocalPartySaveData
+	NewVs       vss.Vs
+	NewShares   vss.Shares
+	VD          cmt.HashDeCommitment
+	NewXi       *big.Int
+	NewKs       []*big.Int
+	NewBigXjs   []*crypto.ECPoint
+}
+
+// Snapshot captures the state of the party, including its secrets, so that it can be resumed with RestoreLocalParty
+// after a restart. The snapshot is encrypted under the parameters' snapshot key.
+func (p *LocalParty) Snapshot() ([]byte, error) {
+	return tss.BaseSnapshot(p, TaskName, func() ([]tss.ParsedMessage, []byte, error) {
+		secrets, err := json.Marshal(&snapshotData{
+			Input:     p.input,
+			Save:      p.save,
+			NewVs:     p.temp.NewVs,
+			NewShares: p.temp.NewShares,
+			VD:        p.temp.VD,
+			NewXi:     p.temp.newXi,
+			NewKs:     p.temp.newKs,
+			NewBigXjs: p.temp.newBigXjs,
+		})
+		return p.temp.messages(), secrets, err
+	})
+}
+
+// RestoreLocalParty recreates a party from its Snapshot. The key of an old committee party is restored from the snapshot.
+// The party continues in the round that it was in without starting it again, so no fresh randomness is drawn;
+// it must not be started.
+func RestoreLocalParty(
+	params *tss.ReSharingParameters,
+	snapshot []byte,
+	out chan<- tss.Message,
+	end chan<- *keygen.LocalPartySaveData,
+) (tss.Party, error) {
+	return tss.BaseRestore(params.Parameters, snapshot, TaskName, func(secrets []byte) (tss.Party, error) {
+		data := new(snapshotData)
+		if err := json.Unmarshal(secrets, data); err != nil {
+			return nil, err
+		}
+		p := NewLocalParty(params, data.Input, out, end).(*LocalParty)
+		p.input, p.save = data.Input, data.Save
+		p.temp.NewVs = data.NewVs
+		p.temp.NewShares = data.NewShares
+		p.temp.VD = data.VD
+		p.temp.newXi = data.NewXi
+		p.temp.newKs = data.NewKs
+		p.temp.newBigXjs = data.NewBigXjs
+		return p, nil
+	})
+}
+
+func (store *localMessageStore) messages() []tss.ParsedMessage {
+	var msgs []tss.ParsedMessage
+	for _, stored := range [][]tss.ParsedMessage{
+		store.dgRound1Messages,
+		store.dgRound2Messages,
+		store.dgRound3Message1s,
+		store.dgRound3Message2s,
+		store.dgRound4Messages,
+	} {
+		msgs = append(msgs, stored...)
+	}
+	return msgs
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/agl/ed25519/edwards25519"
 	"github.com/decred/dcrd/dcrec/edwards/v2"
 
+	"github.com/SafeMPC/tss-lib/common"
 	"github.com/SafeMPC/tss-lib/tss"
 )
 
@@ -26,13 +27,18 @@ func (round *finalization) Start() *tss.Error {
 	round.resetOK()
 
 	sumS := round.temp.si
-	for j := range round.Parties().IDs() {
+	for j, Pj := range round.Parties().IDs() {
 		round.ok[j] = true
 		if j == round.PartyID().Index {
 			continue
 		}
 		r3msg := round.temp.signRound3Messages[j].Content().(*SignRound3Message)
-		sjBytes := bigIntToEncodedBytes(r3msg.UnmarshalS())
+		sj := r3msg.UnmarshalS()
+		if !common.IsInInterval(sj, round.Params().EC().Params().N) {
+			return round.WrapError(tss.Classify(errors.New("s is not less than the curve order"), tss.ErrBadMessage), Pj).
+				WithMessages(round.temp.signRound3Messages[j])
+		}
+		sjBytes := bigIntToEncodedBytes(sj)
 		var tmpSumS [32]byte
 		edwards25519.ScMulAdd(&tmpSumS, sumS, bigIntToEncodedBytes(big.NewInt(1)), sjBytes)
 		sumS = &tmpSumS
@@ -66,6 +72,7 @@ func (round *finalization) Start() *tss.Error {
 	}
 
 	// Send the signature data (now in standard Ed25519 big-endian format)
+	round.temp.zeroize()
 	round.end <- round.data
 
 	return nil
@@ -84,3 +91,7 @@ func (round *finalization) Update() (bool, *tss.Error) {
 func (round *finalization) NextRound() tss.Round {
 	return nil // finished!
 }
+
+func (round *finalization) IsFinal() bool {
+	return true
+}
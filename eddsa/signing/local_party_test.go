@@ -7,12 +7,18 @@
 package signing
 
 import (
+	"context"
 	"crypto/ed25519"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math/big"
+	"os"
+	"path/filepath"
+	"runtime"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/agl/ed25519/edwards25519"
 	"github.com/decred/dcrd/dcrec/edwards/v2"
@@ -23,6 +29,7 @@ import (
 	"github.com/SafeMPC/tss-lib/eddsa/keygen"
 	"github.com/SafeMPC/tss-lib/test"
 	"github.com/SafeMPC/tss-lib/tss"
+	"github.com/SafeMPC/tss-lib/tss/transport"
 )
 
 const (
@@ -34,9 +41,6 @@ func setUp(level string) {
 	if err := log.SetLogLevel("tss-lib", level); err != nil {
 		panic(err)
 	}
-
-	// only for test
-	tss.SetCurve(tss.Edwards())
 }
 
 func TestE2EConcurrent(t *testing.T) {
@@ -59,7 +63,9 @@ func TestE2EConcurrent(t *testing.T) {
 	outCh := make(chan tss.Message, len(signPIDs))
 	endCh := make(chan *common.SignatureData, len(signPIDs))
 
-	updater := test.SharedPartyUpdater
+	hub := transport.NewHub()
+	defer hub.Close()
+	updater := test.SharedTransportUpdater
 
 	msg := big.NewInt(200)
 	// init the parties
@@ -68,6 +74,9 @@ func TestE2EConcurrent(t *testing.T) {
 
 		P := NewLocalParty(msg, params, keys[i], outCh, endCh).(*LocalParty)
 		parties = append(parties, P)
+		conn, err := hub.Connect(P.PartyID())
+		assert.NoError(t, err)
+		go updater(P, conn, errCh)
 		go func(P *LocalParty) {
 			if err := P.Start(); err != nil {
 				errCh <- err
@@ -85,19 +94,8 @@ signing:
 			break signing
 
 		case msg := <-outCh:
-			dest := msg.GetTo()
-			if dest == nil {
-				for _, P := range parties {
-					if P.PartyID().Index == msg.GetFrom().Index {
-						continue
-					}
-					go updater(P, msg, errCh)
-				}
-			} else {
-				if dest[0].Index == msg.GetFrom().Index {
-					t.Fatalf("party %d tried to send a message to itself (%d)", dest[0].Index, msg.GetFrom().Index)
-				}
-				go updater(parties[dest[0].Index], msg, errCh)
+			if err := hub.Send(msg); err != nil {
+				assert.FailNow(t, err.Error())
 			}
 
 		case <-endCh:
@@ -121,6 +119,11 @@ signing:
 				fmt.Printf("R: %s\n", R.String())
 				// END check s correctness
 
+				for _, p := range parties {
+					assert.Zero(t, p.temp.wi.Sign(), "wi must be zeroized once signing has finished")
+					assert.Zero(t, p.temp.ri.Sign(), "ri must be zeroized once signing has finished")
+				}
+
 				// BEGIN EDDSA verify
 				pkX, pkY := keys[0].EDDSAPub.X(), keys[0].EDDSAPub.Y()
 				pk := edwards.PublicKey{
@@ -170,7 +173,9 @@ func TestE2EConcurrentWithLeadingZeroInMSG(t *testing.T) {
 	outCh := make(chan tss.Message, len(signPIDs))
 	endCh := make(chan *common.SignatureData, len(signPIDs))
 
-	updater := test.SharedPartyUpdater
+	hub := transport.NewHub()
+	defer hub.Close()
+	updater := test.SharedTransportUpdater
 
 	msg, _ := hex.DecodeString("00f163ee51bcaeff9cdff5e0e3c1a646abd19885fffbab0b3b4236e0cf95c9f5")
 	// init the parties
@@ -178,6 +183,9 @@ func TestE2EConcurrentWithLeadingZeroInMSG(t *testing.T) {
 		params := tss.NewParameters(tss.Edwards(), p2pCtx, signPIDs[i], len(signPIDs), threshold)
 		P := NewLocalParty(new(big.Int).SetBytes(msg), params, keys[i], outCh, endCh, len(msg)).(*LocalParty)
 		parties = append(parties, P)
+		conn, err := hub.Connect(P.PartyID())
+		assert.NoError(t, err)
+		go updater(P, conn, errCh)
 		go func(P *LocalParty) {
 			if err := P.Start(); err != nil {
 				errCh <- err
@@ -195,19 +203,8 @@ signing:
 			break signing
 
 		case msg := <-outCh:
-			dest := msg.GetTo()
-			if dest == nil {
-				for _, P := range parties {
-					if P.PartyID().Index == msg.GetFrom().Index {
-						continue
-					}
-					go updater(P, msg, errCh)
-				}
-			} else {
-				if dest[0].Index == msg.GetFrom().Index {
-					t.Fatalf("party %d tried to send a message to itself (%d)", dest[0].Index, msg.GetFrom().Index)
-				}
-				go updater(parties[dest[0].Index], msg, errCh)
+			if err := hub.Send(msg); err != nil {
+				assert.FailNow(t, err.Error())
 			}
 
 		case <-endCh:
@@ -352,3 +349,122 @@ func testStandardEd25519Verification(
 	t.Logf("Public key Y: %s", keyData.EDDSAPub.Y().String())
 	t.Logf("Message length: %d bytes", len(message))
 }
+
+func TestAbort(t *testing.T) {
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	params := tss.NewParameters(tss.Edwards(), p2pCtx, signPIDs[0], len(signPIDs), testThreshold)
+	outCh := make(chan tss.Message, len(signPIDs))
+	P := NewLocalParty(big.NewInt(42), params, keys[0], outCh, make(chan *common.SignatureData, 1)).(*LocalParty)
+	if !assert.Nil(t, P.Start()) {
+		return
+	}
+	wi, ri := P.temp.wi, P.temp.ri
+	assert.NotZero(t, wi.Sign())
+	assert.NotZero(t, ri.Sign())
+
+	culprit := signPIDs[1]
+	err = P.Abort(P.WrapError(errors.New("the peer went away"), culprit))
+	if assert.NotNil(t, err) {
+		assert.ErrorIs(t, err, tss.ErrAborted)
+		assert.Equal(t, tss.CodeAborted, err.(*tss.Error).Code())
+		assert.Equal(t, []*tss.PartyID{culprit}, err.(*tss.Error).Culprits())
+	}
+	assert.Zero(t, wi.Sign(), "wi must be zeroized")
+	assert.Zero(t, ri.Sign(), "ri must be zeroized")
+	assert.NotZero(t, keys[0].Xi.Sign(), "the key must not be zeroized")
+	assert.False(t, P.Running())
+	assert.Nil(t, P.temp.signRound1Messages)
+
+	// the party rejects every message afterwards
+	_, tErr := P.Update(NewSignRound1Message(culprit, big.NewInt(1)))
+	assert.Equal(t, err, tErr)
+	assert.Equal(t, err, P.Abort(errors.New("again")), "aborting again returns the same error")
+	assert.NotNil(t, P.Start())
+}
+
+const transcriptFixtureFile = "%s/../../test/_eddsa_fixtures/signing_transcript_v%d.json"
+
+// TestReplayTranscript replays a saved transcript of the first signer so that a change to the computation of a party
+// is caught. The transcript is recorded and saved when it does not exist yet.
+func TestReplayTranscript(t *testing.T) {
+	keys, signPIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 1)
+	if !assert.NoError(t, err, "should load keygen fixtures") {
+		return
+	}
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	msg := big.NewInt(42)
+
+	_, callerFileName, _, _ := runtime.Caller(0)
+	fixtureFileName := fmt.Sprintf(transcriptFixtureFile, filepath.Dir(callerFileName), tss.TranscriptVersion)
+	bz, err := os.ReadFile(fixtureFileName)
+	if os.IsNotExist(err) {
+		bz = recordSigning(t, keys, signPIDs, msg)
+		assert.NoError(t, os.WriteFile(fixtureFileName, bz, 0600))
+		t.Logf("Saved a signing transcript: %s", fixtureFileName)
+	} else if !assert.NoError(t, err) {
+		return
+	}
+	transcript, err := tss.UnmarshalTranscript(bz)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	params := tss.NewParameters(tss.Edwards(), p2pCtx, signPIDs[0], len(signPIDs), testThreshold)
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan *common.SignatureData, 1)
+	replay, err := tss.NewReplay(transcript, NewLocalParty(msg, params, keys[0], outCh, endCh), outCh)
+	if !assert.NoError(t, err) {
+		return
+	}
+	report := replay.Run()
+	assert.Nil(t, report.Divergence)
+	assert.Nil(t, report.Err)
+	assert.Equal(t, len(transcript.Inbound)+1, report.Steps)
+
+	pk := edwards.PublicKey{Curve: tss.Edwards(), X: keys[0].EDDSAPub.X(), Y: keys[0].EDDSAPub.Y()}
+	sig, err := edwards.ParseSignature((<-endCh).GetSignature())
+	if assert.NoError(t, err) {
+		assert.True(t, edwards.Verify(&pk, msg.Bytes(), sig.R, sig.S), "eddsa verify must pass")
+	}
+}
+
+// recordSigning signs msg with every party in signPIDs and returns the marshalled transcript of the first
+func recordSigning(t *testing.T, keys []keygen.LocalPartySaveData, signPIDs tss.SortedPartyIDs, msg *big.Int) []byte {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	hub := transport.NewHub()
+	defer hub.Close()
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	rec := tss.NewRecorder(signPIDs[0])
+
+	errCh := make(chan *tss.Error, len(signPIDs))
+	for i, pID := range signPIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pID, len(signPIDs), testThreshold)
+		if i == 0 {
+			params.SetRecorder(rec)
+		}
+		conn, err := hub.Connect(pID)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		outCh := make(chan tss.Message, len(signPIDs))
+		endCh := make(chan *common.SignatureData, 1)
+		P := NewLocalParty(msg, params, keys[i], outCh, endCh)
+		go func() {
+			_, err := tss.Run(ctx, P, conn, outCh, endCh)
+			errCh <- err
+		}()
+	}
+	for range signPIDs {
+		if err := <-errCh; err != nil {
+			t.Fatalf("signing failed: %v", err)
+		}
+	}
+	bz, err := rec.Transcript().Marshal()
+	if err != nil {
+		t.Fatalf("unable to marshal the transcript: %v", err)
+	}
+	return bz
+}
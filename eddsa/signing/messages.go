@@ -48,7 +48,11 @@ func NewSignRound1Message(
 
 func (m *SignRound1Message) ValidateBasic() bool {
 	return m.Commitment != nil &&
-		common.NonEmptyBytes(m.GetCommitment())
+		common.BoundedBytes(m.GetCommitment(), tss.MaxHashBytes)
+}
+
+func (m *SignRound1Message) RoundNumber() int {
+	return 1
 }
 
 func (m *SignRound1Message) UnmarshalCommitment() *big.Int {
@@ -79,10 +83,14 @@ func NewSignRound2Message(
 
 func (m *SignRound2Message) ValidateBasic() bool {
 	return m != nil &&
-		common.NonEmptyMultiBytes(m.DeCommitment, 3) &&
-		common.NonEmptyBytes(m.ProofAlphaX) &&
-		common.NonEmptyBytes(m.ProofAlphaY) &&
-		common.NonEmptyBytes(m.ProofT)
+		common.BoundedMultiBytes(m.DeCommitment, tss.MaxScalarBytes, 3) &&
+		common.BoundedBytes(m.ProofAlphaX, tss.MaxScalarBytes) &&
+		common.BoundedBytes(m.ProofAlphaY, tss.MaxScalarBytes) &&
+		common.BoundedBytes(m.ProofT, tss.MaxScalarBytes)
+}
+
+func (m *SignRound2Message) RoundNumber() int {
+	return 2
 }
 
 func (m *SignRound2Message) UnmarshalDeCommitment() []*big.Int {
@@ -123,7 +131,11 @@ func NewSignRound3Message(
 
 func (m *SignRound3Message) ValidateBasic() bool {
 	return m != nil &&
-		common.NonEmptyBytes(m.S)
+		common.BoundedBytes(m.S, tss.MaxScalarBytes)
+}
+
+func (m *SignRound3Message) RoundNumber() int {
+	return 3
 }
 
 func (m *SignRound3Message) UnmarshalS() *big.Int {
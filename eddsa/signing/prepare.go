@@ -25,7 +25,8 @@ func PrepareForSigning(ec elliptic.Curve, i, pax int, xi *big.Int, ks []*big.Int
 	}
 
 	// 1-4.
-	wi = xi
+	// a copy, so that the temp data can be zeroized without touching the key
+	wi = new(big.Int).Set(xi)
 	for j := 0; j < pax; j++ {
 		if j == i {
 			continue
@@ -9,7 +9,6 @@ package signing
 import (
 	"errors"
 	"fmt"
-	"math/big"
 
 	"github.com/SafeMPC/tss-lib/common"
 	"github.com/SafeMPC/tss-lib/crypto"
@@ -34,7 +33,7 @@ func (round *round1) Start() *tss.Error {
 	round.started = true
 	round.resetOK()
 
-	round.temp.ssidNonce = new(big.Int).SetUint64(0)
+	round.temp.ssidNonce = round.SSIDNonce()
 	var err error
 	round.temp.ssid, err = round.getSSID()
 	if err != nil {
@@ -46,24 +46,25 @@ func (round *round3) Start() *tss.Error {
 		cmtDeCmt := commitments.HashCommitDecommit{C: round.temp.cjs[j], D: r2msg.UnmarshalDeCommitment()}
 		ok, coordinates := cmtDeCmt.DeCommit()
 		if !ok {
-			return round.WrapError(errors.New("de-commitment verify failed"))
+			return round.WrapError(tss.Classify(errors.New("de-commitment verify failed"), tss.ErrCommitmentMismatch), Pj).
+				WithMessages(round.temp.signRound1Messages[j], msg)
 		}
 		if len(coordinates) != 2 {
-			return round.WrapError(errors.New("length of de-commitment should be 2"))
+			return round.WrapError(tss.Classify(errors.New("length of de-commitment should be 2"), tss.ErrBadMessage), Pj).WithMessages(msg)
 		}
 
 		Rj, err := crypto.NewECPoint(round.Params().EC(), coordinates[0], coordinates[1])
 		Rj = Rj.EightInvEight()
 		if err != nil {
-			return round.WrapError(errors.Wrapf(err, "NewECPoint(Rj)"), Pj)
+			return round.WrapError(tss.Classify(errors.Wrapf(err, "NewECPoint(Rj)"), tss.ErrBadMessage), Pj).WithMessages(msg)
 		}
 		proof, err := r2msg.UnmarshalZKProof(round.Params().EC())
 		if err != nil {
-			return round.WrapError(errors.New("failed to unmarshal Rj proof"), Pj)
+			return round.WrapError(tss.Classify(errors.New("failed to unmarshal Rj proof"), tss.ErrBadMessage), Pj).WithMessages(msg)
 		}
-		ok = proof.Verify(ContextJ, Rj)
+		ok = round.verifyProof("schnorr", func() bool { return proof.Verify(ContextJ, Rj) })
 		if !ok {
-			return round.WrapError(errors.New("failed to prove Rj"), Pj)
+			return round.WrapError(tss.Classify(errors.New("failed to prove Rj"), tss.ErrInvalidProof), Pj).WithMessages(msg)
 		}
 
 		extendedRj := ecPointToExtendedElement(round.Params().EC(), Rj.X(), Rj.Y(), round.Rand())
@@ -0,0 +1,46 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/SafeMPC/tss-lib/common"
+	"github.com/SafeMPC/tss-lib/eddsa/keygen"
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+// SessionInput is the input of a tss.ProtocolEdDSASigning session of a tss.SessionManager
+type SessionInput struct {
+	Msg *big.Int
+	Key keygen.LocalPartySaveData
+	// FullBytesLen is the length of the message in bytes, if it has leading zeros
+	FullBytesLen int
+}
+
+func init() {
+	tss.RegisterProtocol(tss.ProtocolEdDSASigning, newSessionParty)
+}
+
+func newSessionParty(session *tss.Session, input any) (tss.Party, error) {
+	var in SessionInput
+	switch input := input.(type) {
+	case SessionInput:
+		in = input
+	case *SessionInput:
+		in = *input
+	default:
+		return nil, fmt.Errorf("%s: expected a signing.SessionInput, got %T", tss.ProtocolEdDSASigning, input)
+	}
+	if in.Msg == nil {
+		return nil, errors.New("the session has no message to sign")
+	}
+	end := tss.SessionEnd[*common.SignatureData](session)
+	return NewLocalParty(in.Msg, session.Params(), in.Key, session.Out(), end, in.FullBytesLen), nil
+}
@@ -0,0 +1,107 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/SafeMPC/tss-lib/common"
+	"github.com/SafeMPC/tss-lib/crypto"
+	cmt "github.com/SafeMPC/tss-lib/crypto/commitments"
+	"github.com/SafeMPC/tss-lib/eddsa/keygen"
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+// snapshotData is the state of an in-progress signing that is encrypted into a snapshot
+type snapshotData struct {
+	Keys         keygen.LocalPartySaveData
+	Data         []byte
+	WI           *big.Int
+	M            *big.Int
+	RI           *big.Int
+	FullBytesLen int
+	PointRi      *crypto.ECPoint
+	DeCommit     cmt.HashDeCommitment
+	Cjs          []*big.Int
+	Si           *[32]byte
+	R            *big.Int
+	SSID         []byte
+	SSIDNonce    *big.Int
+}
+
+// Snapshot captures the state of the party, including its secrets, so that it can be resumed with RestoreLocalParty
+// after a restart. The snapshot is encrypted under the parameters' snapshot key.
+func (p *LocalParty) Snapshot() ([]byte, error) {
+	return tss.BaseSnapshot(p, TaskName, func() ([]tss.ParsedMessage, []byte, error) {
+		data, err := proto.Marshal(p.data)
+		if err != nil {
+			return nil, nil, err
+		}
+		secrets, err := json.Marshal(&snapshotData{
+			Keys:         p.keys,
+			Data:         data,
+			WI:           p.temp.wi,
+			M:            p.temp.m,
+			RI:           p.temp.ri,
+			FullBytesLen: p.temp.fullBytesLen,
+			PointRi:      p.temp.pointRi,
+			DeCommit:     p.temp.deCommit,
+			Cjs:          p.temp.cjs,
+			Si:           p.temp.si,
+			R:            p.temp.r,
+			SSID:         p.temp.ssid,
+			SSIDNonce:    p.temp.ssidNonce,
+		})
+		return p.temp.messages(), secrets, err
+	})
+}
+
+// RestoreLocalParty recreates a party from its Snapshot. The message and the key are restored from the snapshot.
+// The party continues in the round that it was in without starting it again, so no fresh nonce is drawn; it must not be started.
+func RestoreLocalParty(
+	params *tss.Parameters,
+	snapshot []byte,
+	out chan<- tss.Message,
+	end chan<- *common.SignatureData,
+) (tss.Party, error) {
+	return tss.BaseRestore(params, snapshot, TaskName, func(secrets []byte) (tss.Party, error) {
+		data := new(snapshotData)
+		if err := json.Unmarshal(secrets, data); err != nil {
+			return nil, err
+		}
+		p := NewLocalParty(data.M, params, data.Keys, out, end, data.FullBytesLen).(*LocalParty)
+		if err := proto.Unmarshal(data.Data, p.data); err != nil {
+			return nil, err
+		}
+		p.keys = data.Keys
+		p.temp.wi = data.WI
+		p.temp.ri = data.RI
+		p.temp.pointRi = data.PointRi
+		p.temp.deCommit = data.DeCommit
+		p.temp.cjs = data.Cjs
+		p.temp.si = data.Si
+		p.temp.r = data.R
+		p.temp.ssid = data.SSID
+		p.temp.ssidNonce = data.SSIDNonce
+		return p, nil
+	})
+}
+
+func (store *localMessageStore) messages() []tss.ParsedMessage {
+	var msgs []tss.ParsedMessage
+	for _, stored := range [][]tss.ParsedMessage{
+		store.signRound1Messages,
+		store.signRound2Messages,
+		store.signRound3Messages,
+	} {
+		msgs = append(msgs, stored...)
+	}
+	return msgs
+}
@@ -0,0 +1,93 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SafeMPC/tss-lib/common"
+	"github.com/SafeMPC/tss-lib/eddsa/keygen"
+	"github.com/SafeMPC/tss-lib/test"
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+func TestSnapshotRestore(t *testing.T) {
+	setUp("info")
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	snapshotKey := make([]byte, 32)
+	_, _ = rand.Read(snapshotKey)
+	newParams := func(pID *tss.PartyID) *tss.Parameters {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pID, len(signPIDs), testThreshold)
+		params.SetSnapshotKey(snapshotKey)
+		return params
+	}
+
+	msg := big.NewInt(42)
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs)*len(signPIDs)*2)
+	endCh := make(chan *common.SignatureData, len(signPIDs))
+	parties := make([]*LocalParty, len(signPIDs))
+	for i, pID := range signPIDs {
+		parties[i] = NewLocalParty(msg, newParams(pID), keys[i], outCh, endCh).(*LocalParty)
+		if err := parties[i].Start(); err != nil {
+			assert.FailNow(t, err.Error())
+		}
+	}
+
+	restored := false
+	sigs := make([]*common.SignatureData, 0, len(signPIDs))
+	for len(sigs) < len(signPIDs) {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case sig := <-endCh:
+			sigs = append(sigs, sig)
+		case msg := <-outCh:
+			if dest := msg.GetTo(); dest == nil {
+				for _, P := range parties {
+					test.SharedPartyUpdater(P, msg, errCh)
+				}
+			} else {
+				test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+			}
+			if restored || parties[0].temp.signRound2Messages[1] == nil {
+				continue
+			}
+			// restart party 0 part way through round 2, after its nonce r_i was drawn in round 1
+			snapshot, err := parties[0].Snapshot()
+			if !assert.NoError(t, err) {
+				return
+			}
+			ri := parties[0].temp.ri
+			P, err := RestoreLocalParty(newParams(signPIDs[0]), snapshot, outCh, endCh)
+			if !assert.NoError(t, err) {
+				return
+			}
+			parties[0] = P.(*LocalParty)
+			assert.Equal(t, 0, ri.Cmp(parties[0].temp.ri), "the restored party must not draw a fresh nonce")
+			assert.NotNil(t, parties[0].Start(), "a restored party must not start again")
+			restored = true
+		}
+	}
+	assert.True(t, restored)
+
+	pk := edwards.PublicKey{Curve: tss.Edwards(), X: keys[0].EDDSAPub.X(), Y: keys[0].EDDSAPub.Y()}
+	for _, sig := range sigs {
+		parsed, err := edwards.ParseSignature(sig.GetSignature())
+		if assert.NoError(t, err) {
+			assert.True(t, edwards.Verify(&pk, msg.Bytes(), parsed.R, parsed.S), "eddsa verify must pass")
+		}
+	}
+}
@@ -7,7 +7,11 @@
 package test
 
 import (
+	"context"
+	"errors"
+
 	"github.com/SafeMPC/tss-lib/tss"
+	"github.com/SafeMPC/tss-lib/tss/transport"
 )
 
 func SharedPartyUpdater(party tss.Party, msg tss.Message, errCh chan<- *tss.Error) {
@@ -29,3 +33,19 @@ func SharedPartyUpdater(party tss.Party, msg tss.Message, errCh chan<- *tss.Erro
 		errCh <- err
 	}
 }
+
+// SharedTransportUpdater feeds every message received on the transport into the party until the transport is closed
+func SharedTransportUpdater(party tss.Party, conn transport.Transport, errCh chan<- *tss.Error) {
+	for {
+		bz, routing, err := conn.Receive(context.Background())
+		if err != nil {
+			if !errors.Is(err, transport.ErrClosed) {
+				errCh <- party.WrapError(err)
+			}
+			return
+		}
+		if _, err := party.UpdateFromBytes(bz, routing.From, routing.IsBroadcast); err != nil {
+			errCh <- err
+		}
+	}
+}
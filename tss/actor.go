@@ -0,0 +1,257 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// DefaultInboxSize is the number of messages that an Actor queues when no inbox size is given
+const DefaultInboxSize = 64
+
+// Actor runs a party on a goroutine of its own. Messages are queued in a bounded inbox and processed one at a time on
+// that goroutine, so the rounds that they complete, and the cryptography that those rounds do, never run on the
+// goroutines that deliver the messages. Update and UpdateFromBytes return as soon as the message has been queued.
+// The party's own Update and UpdateFromBytes stay synchronous: they queue the message on the actor and wait until it
+// has been processed, blocking while the inbox is full. A party that is not run by an Actor gets one of its own the
+// first time that it is updated; see BaseUpdate.
+//
+// The actor stops once the party has finished, at the first error, which is sent on Errors, or when it is stopped.
+// The party's results are sent on the `out` and `end` channels that it was constructed with, as usual; they should be
+// buffered or drained concurrently so that the actor never blocks on them.
+type Actor struct {
+	party   Party
+	inbox   chan func() *Error
+	errCh   chan *Error
+	quit    chan struct{}
+	done    chan struct{}
+	started atomic.Bool
+	stop    sync.Once
+	// an actor that BaseUpdate created runs its goroutine only while its inbox has messages
+	onDemand bool
+	busy     atomic.Bool
+}
+
+// NewActor creates an Actor for a party that has not been started. Up to inboxSize messages are queued for it, or
+// DefaultInboxSize if inboxSize is not positive.
+func NewActor(party Party, inboxSize int) *Actor {
+	a := newActor(party, inboxSize)
+	party.lock()
+	defer party.unlock()
+	party.setRunner(a)
+	return a
+}
+
+func newActor(party Party, inboxSize int) *Actor {
+	if inboxSize <= 0 {
+		inboxSize = DefaultInboxSize
+	}
+	return &Actor{
+		party: party,
+		inbox: make(chan func() *Error, inboxSize),
+		errCh: make(chan *Error, 1),
+		quit:  make(chan struct{}),
+		done:  make(chan struct{}),
+	}
+}
+
+// runnerOf returns the actor that runs the party, creating one for a party that is not run by an Actor. Such a party
+// is started by its own Start rather than by the actor, and an error does not stop the actor, but is returned to the
+// caller of the Update that it failed, so that the party can still be updated after a bad message. The actor's
+// goroutine exits whenever its inbox is empty, so a party that is abandoned does not leave it behind.
+func runnerOf(party Party) *Actor {
+	party.lock()
+	defer party.unlock()
+	if a := party.runner(); a != nil {
+		return a
+	}
+	a := newActor(party, DefaultInboxSize)
+	a.onDemand = true
+	a.started.Store(true)
+	party.setRunner(a)
+	return a
+}
+
+// Party returns the party that the actor runs
+func (a *Actor) Party() Party {
+	return a.party
+}
+
+// Start starts the actor's goroutine, which starts the party and then processes the messages in its inbox.
+// Messages that were queued before Start are processed once the party has started.
+func (a *Actor) Start() *Error {
+	if !a.started.CompareAndSwap(false, true) {
+		return NewError(errors.New("the actor has already been started"), "", -1, a.party.PartyID())
+	}
+	go a.run()
+	return nil
+}
+
+// Update queues a message for the party. It fails if the message is invalid, if the inbox is full with an error that
+// wraps ErrInboxFull, or if the actor has stopped; a message that was queued may still fail, which is reported on
+// Errors.
+func (a *Actor) Update(msg ParsedMessage) (ok bool, err *Error) {
+	if _, err := a.party.ValidateMessage(msg); err != nil {
+		return false, err
+	}
+	return a.enqueue(func() *Error {
+		_, err := update(a.party, msg, a.party.taskName())
+		return err
+	})
+}
+
+// UpdateFromBytes queues a message received on the wire for the party like Update; it is parsed once it is processed,
+// so wireBytes must not be modified afterwards.
+func (a *Actor) UpdateFromBytes(wireBytes []byte, from *PartyID, isBroadcast bool) (ok bool, err *Error) {
+	return a.enqueue(func() *Error {
+		msg, err := a.party.FirstRound().Params().ParseWireMessage(wireBytes, from, isBroadcast)
+		if err != nil {
+			return WrapParseError(a.party, err, wireBytes, from, isBroadcast)
+		}
+		_, tErr := update(a.party, msg, a.party.taskName())
+		return tErr
+	})
+}
+
+// Pending is the number of messages queued in the inbox, which is a measure of the back-pressure on the party
+func (a *Actor) Pending() int {
+	return len(a.inbox)
+}
+
+// InboxSize is the number of messages that the inbox can hold
+func (a *Actor) InboxSize() int {
+	return cap(a.inbox)
+}
+
+// Errors receives the error that stopped the actor, if any
+func (a *Actor) Errors() <-chan *Error {
+	return a.errCh
+}
+
+// Done is closed once the actor has stopped
+func (a *Actor) Done() <-chan struct{} {
+	return a.done
+}
+
+// Stop stops the actor after the message that it is processing, if any; the messages left in the inbox are dropped
+func (a *Actor) Stop() {
+	a.stop.Do(func() {
+		close(a.quit)
+		// an actor that was never started has no goroutine to close done
+		if a.started.CompareAndSwap(false, true) {
+			close(a.done)
+		}
+	})
+}
+
+func (a *Actor) enqueue(update func() *Error) (bool, *Error) {
+	select {
+	case <-a.quit:
+		return false, a.stoppedError()
+	case <-a.done:
+		return false, a.stoppedError()
+	default:
+	}
+	select {
+	case a.inbox <- update:
+		return true, nil
+	default:
+		err := fmt.Errorf("%d messages are waiting to be processed", cap(a.inbox))
+		return false, NewError(Classify(err, ErrInboxFull), "", -1, a.party.PartyID())
+	}
+}
+
+// process queues an update for the party's own Update and waits until the actor has processed it. Unlike enqueue, it
+// waits for room in the inbox rather than failing when the inbox is full.
+func (a *Actor) process(update func() (bool, *Error)) (bool, *Error) {
+	var ok bool
+	var err *Error
+	processed := make(chan struct{})
+	select {
+	case <-a.quit:
+		return false, a.stoppedError()
+	case <-a.done:
+		return false, a.stoppedError()
+	case a.inbox <- func() *Error {
+		defer close(processed)
+		ok, err = update()
+		return err
+	}:
+	}
+	if a.onDemand {
+		a.wake()
+	}
+	select {
+	case <-processed:
+	case <-a.done:
+		// the update may have been the last that the actor processed
+		select {
+		case <-processed:
+		default:
+			return false, NewError(errors.New("the actor stopped before the message was processed"), "", -1, a.party.PartyID())
+		}
+	}
+	return ok, err
+}
+
+// wake starts the goroutine of an on-demand actor unless it is running
+func (a *Actor) wake() {
+	if a.busy.CompareAndSwap(false, true) {
+		go a.drain()
+	}
+}
+
+// drain processes the inbox of an on-demand actor until it is empty. The errors are returned by process to the callers
+// of the updates that failed.
+func (a *Actor) drain() {
+	for {
+		select {
+		case update := <-a.inbox:
+			update()
+		default:
+			a.busy.Store(false)
+			// a message queued after the inbox was found empty, but before busy was cleared, is processed here, unless
+			// the process that queued it has started another goroutine already
+			if len(a.inbox) == 0 || !a.busy.CompareAndSwap(false, true) {
+				return
+			}
+		}
+	}
+}
+
+func (a *Actor) stoppedError() *Error {
+	return NewError(errors.New("the actor has stopped"), "", -1, a.party.PartyID())
+}
+
+// finished reports whether the party has finished; it may also be aborted by another goroutine
+func (a *Actor) finished() bool {
+	a.party.lock()
+	defer a.party.unlock()
+	return finished(a.party)
+}
+
+func (a *Actor) run() {
+	defer close(a.done)
+	if err := a.party.Start(); err != nil {
+		a.errCh <- err
+		return
+	}
+	for !a.finished() {
+		select {
+		case <-a.quit:
+			return
+		case update := <-a.inbox:
+			if err := update(); err != nil {
+				a.errCh <- err
+				return
+			}
+		}
+	}
+}
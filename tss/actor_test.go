@@ -0,0 +1,228 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SafeMPC/tss-lib/eddsa/keygen"
+	"github.com/SafeMPC/tss-lib/test"
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+func TestActorKeygen(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(test.TestParticipants)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	outCh := make(chan tss.Message, len(pIDs)*len(pIDs)*3)
+	endCh := make(chan *keygen.LocalPartySaveData, len(pIDs))
+	actors := make([]*tss.Actor, len(pIDs))
+	for i, pID := range pIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pID, len(pIDs), test.TestThreshold)
+		actors[i] = tss.NewActor(keygen.NewLocalParty(params, outCh, endCh), 0)
+		assert.Equal(t, tss.DefaultInboxSize, actors[i].InboxSize())
+		if !assert.Nil(t, actors[i].Start()) {
+			return
+		}
+	}
+	assert.NotNil(t, actors[0].Start(), "an actor can only be started once")
+
+	deliver := func(actor *tss.Actor, msg tss.Message) {
+		if actor.Party().PartyID() == msg.GetFrom() {
+			return
+		}
+		bz, routing, err := msg.WireBytes()
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		_, tErr := actor.UpdateFromBytes(bz, routing.From, routing.IsBroadcast)
+		assert.Nil(t, tErr)
+	}
+	timeout := time.After(time.Minute)
+	saves := make([]*keygen.LocalPartySaveData, 0, len(pIDs))
+	for len(saves) < len(pIDs) {
+		select {
+		case <-timeout:
+			assert.FailNow(t, "timed out")
+		case save := <-endCh:
+			saves = append(saves, save)
+		case msg := <-outCh:
+			if dest := msg.GetTo(); dest == nil {
+				for _, actor := range actors {
+					deliver(actor, msg)
+				}
+			} else {
+				deliver(actors[dest[0].Index], msg)
+			}
+		}
+		for _, actor := range actors {
+			select {
+			case err := <-actor.Errors():
+				assert.FailNow(t, err.Error())
+			default:
+			}
+		}
+	}
+	for i, actor := range actors {
+		<-actor.Done() // the actor stops once its party has finished
+		assert.True(t, saves[i].EDDSAPub.Equals(saves[0].EDDSAPub))
+	}
+	_, err := actors[0].Update(keygen.NewKGRound1Message(pIDs[1], big.NewInt(42)))
+	assert.NotNil(t, err, "a stopped actor must not accept messages")
+}
+
+func TestActorBackPressure(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(2)
+	params := tss.NewParameters(tss.Edwards(), tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), 1)
+	actor := tss.NewActor(keygen.NewLocalParty(params, make(chan tss.Message, 4), make(chan *keygen.LocalPartySaveData, 1)), 1)
+	defer actor.Stop()
+
+	// messages are queued until the actor is started
+	msg := keygen.NewKGRound1Message(pIDs[1], big.NewInt(42))
+	ok, err := actor.Update(msg)
+	assert.True(t, ok)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, actor.Pending())
+	ok, err = actor.Update(msg)
+	assert.False(t, ok)
+	if assert.NotNil(t, err) {
+		assert.True(t, errors.Is(err, tss.ErrInboxFull))
+		assert.Equal(t, tss.CodeInboxFull, err.Code())
+	}
+
+	actor.Stop()
+	<-actor.Done()
+	_, err = actor.Update(msg)
+	assert.NotNil(t, err, "a stopped actor must not accept messages")
+}
+
+func TestActorAbort(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(2)
+	params := tss.NewParameters(tss.Edwards(), tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), 1)
+	party := keygen.NewLocalParty(params, make(chan tss.Message, 4), make(chan *keygen.LocalPartySaveData, 1))
+	actor := tss.NewActor(party, 0)
+	if !assert.Nil(t, actor.Start()) {
+		return
+	}
+	// the party waits for the round 1 message of its peer until it is aborted
+	err := party.Abort(errors.New("the session was cancelled"))
+	if assert.NotNil(t, err) {
+		assert.ErrorIs(t, err, tss.ErrAborted)
+		assert.Equal(t, tss.CodeAborted, err.Code())
+	}
+	_, _ = actor.Update(keygen.NewKGRound1Message(pIDs[1], big.NewInt(42)))
+	select {
+	case <-actor.Done():
+	case <-time.After(10 * time.Second):
+		assert.FailNow(t, "the actor must stop once its party has been aborted")
+	}
+	assert.False(t, party.Running())
+}
+
+func TestActorSynchronousUpdate(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(test.TestParticipants)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	outCh := make(chan tss.Message, len(pIDs)*len(pIDs)*3)
+	endCh := make(chan *keygen.LocalPartySaveData, len(pIDs))
+	actors := make([]*tss.Actor, len(pIDs))
+	for i, pID := range pIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pID, len(pIDs), test.TestThreshold)
+		actors[i] = tss.NewActor(keygen.NewLocalParty(params, outCh, endCh), 0)
+		if !assert.Nil(t, actors[i].Start()) {
+			return
+		}
+	}
+
+	// the parties' own UpdateFromBytes hand the messages to their actors and return once they have been processed
+	timeout := time.After(time.Minute)
+	saves := make([]*keygen.LocalPartySaveData, 0, len(pIDs))
+	for len(saves) < len(pIDs) {
+		select {
+		case <-timeout:
+			assert.FailNow(t, "timed out")
+		case save := <-endCh:
+			saves = append(saves, save)
+		case msg := <-outCh:
+			bz, routing, err := msg.WireBytes()
+			if !assert.NoError(t, err) {
+				return
+			}
+			for _, actor := range actors {
+				P := actor.Party()
+				if P.PartyID() == msg.GetFrom() || (routing.To != nil && routing.To[0] != P.PartyID()) {
+					continue
+				}
+				ok, tErr := P.UpdateFromBytes(bz, routing.From, routing.IsBroadcast)
+				assert.True(t, ok)
+				if !assert.Nil(t, tErr) {
+					return
+				}
+			}
+		}
+	}
+	for i, actor := range actors {
+		<-actor.Done()
+		assert.True(t, saves[i].EDDSAPub.Equals(saves[0].EDDSAPub))
+	}
+
+	// the error of a message is returned to the caller, and stops the actor as usual
+	params := tss.NewParameters(tss.Edwards(), tss.NewPeerContext(pIDs[:2]), pIDs[0], 2, 1)
+	party := keygen.NewLocalParty(params, make(chan tss.Message, 4), make(chan *keygen.LocalPartySaveData, 1))
+	actor := tss.NewActor(party, 0)
+	if !assert.Nil(t, actor.Start()) {
+		return
+	}
+	ok, err := party.Update(keygen.NewKGRound1Message(pIDs[1], big.NewInt(42)))
+	assert.True(t, ok)
+	assert.Nil(t, err)
+	_, err = party.Update(keygen.NewKGRound1Message(pIDs[1], big.NewInt(43)))
+	if assert.NotNil(t, err) {
+		assert.ErrorIs(t, err, tss.ErrEquivocation)
+	}
+	<-actor.Done()
+	assert.ErrorIs(t, <-actor.Errors(), tss.ErrEquivocation)
+	_, err = party.Update(keygen.NewKGRound1Message(pIDs[1], big.NewInt(42)))
+	assert.NotNil(t, err, "a stopped actor must not accept messages")
+}
+
+func TestUpdateWithoutActor(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(2)
+	params := tss.NewParameters(tss.Edwards(), tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), 1)
+	party := keygen.NewLocalParty(params, make(chan tss.Message, 4), make(chan *keygen.LocalPartySaveData, 1))
+	if !assert.Nil(t, party.Start()) {
+		return
+	}
+
+	// the party gets an actor of its own, which processes the messages one at a time whichever goroutines deliver them
+	msg := keygen.NewKGRound1Message(pIDs[1], big.NewInt(42))
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ok, err := party.Update(msg)
+			assert.True(t, ok)
+			assert.Nil(t, err)
+		}()
+	}
+	wg.Wait()
+
+	// the error of a message is returned to the caller, but does not stop the party
+	_, err := party.Update(keygen.NewKGRound1Message(pIDs[1], big.NewInt(43)))
+	if assert.NotNil(t, err) {
+		assert.ErrorIs(t, err, tss.ErrEquivocation)
+	}
+	ok, err := party.Update(msg)
+	assert.True(t, ok)
+	assert.Nil(t, err)
+	assert.True(t, party.Running())
+}
@@ -18,8 +18,7 @@ package tss
 
 import (
 	"crypto/elliptic"
-	"errors"
-	"reflect"
+	"sync"
 
 	s256k1 "github.com/btcsuite/btcd/btcec/v2"
 	"github.com/decred/dcrd/dcrec/edwards/v2"
@@ -29,29 +28,39 @@ type CurveName string
 
 const (
 	Secp256k1 CurveName = "secp256k1"
+	Secp256r1 CurveName = "secp256r1"
 	Ed25519   CurveName = "ed25519"
 )
 
 var (
-	ec       elliptic.Curve
-	registry map[CurveName]elliptic.Curve
+	registryMtx sync.RWMutex
+	registry    map[CurveName]elliptic.Curve
+	// names in the order that they were first registered in, so that the name of a curve is the same on every run
+	registryNames []CurveName
 )
 
-// Init default curve (secp256k1)
 func init() {
-	ec = s256k1.S256()
-
 	registry = make(map[CurveName]elliptic.Curve)
-	registry[Secp256k1] = s256k1.S256()
-	registry[Ed25519] = edwards.Edwards()
+	RegisterCurve(Secp256k1, s256k1.S256())
+	RegisterCurve(Secp256r1, elliptic.P256())
+	RegisterCurve(Ed25519, edwards.Edwards())
 }
 
+// RegisterCurve registers a curve under a name. A curve that has the domain parameters of a curve registered earlier
+// may be looked up by its name, but GetCurveName keeps returning the name that was registered first.
 func RegisterCurve(name CurveName, curve elliptic.Curve) {
+	registryMtx.Lock()
+	defer registryMtx.Unlock()
+	if _, exist := registry[name]; !exist {
+		registryNames = append(registryNames, name)
+	}
 	registry[name] = curve
 }
 
 // return curve, exist(bool)
 func GetCurveByName(name CurveName) (elliptic.Curve, bool) {
+	registryMtx.RLock()
+	defer registryMtx.RUnlock()
 	if val, exist := registry[name]; exist {
 		return val, true
 	}
@@ -60,9 +69,16 @@ func GetCurveByName(name CurveName) (elliptic.Curve, bool) {
 }
 
 // return name, exist(bool)
+// Curves are identified by their domain parameters, so distinct curves that share a Go type are told apart.
+// When several names have the same parameters, the one that was registered first is returned.
 func GetCurveName(curve elliptic.Curve) (CurveName, bool) {
-	for name, e := range registry {
-		if reflect.TypeOf(curve) == reflect.TypeOf(e) {
+	if curve == nil {
+		return "", false
+	}
+	registryMtx.RLock()
+	defer registryMtx.RUnlock()
+	for _, name := range registryNames {
+		if sameParams(curve.Params(), registry[name].Params()) {
 			return name, true
 		}
 	}
@@ -81,18 +97,11 @@ func SameCurve(lhs, rhs elliptic.Curve) bool {
 	return false
 }
 
-// EC returns the current elliptic curve in use. The default is secp256k1
-func EC() elliptic.Curve {
-	return ec
-}
-
-// SetCurve sets the curve used by TSS. Must be called before Start. The default is secp256k1
-// Deprecated
-func SetCurve(curve elliptic.Curve) {
-	if curve == nil {
-		panic(errors.New("SetCurve received a nil curve"))
+func sameParams(lhs, rhs *elliptic.CurveParams) bool {
+	if lhs == nil || rhs == nil || lhs.P == nil || rhs.P == nil {
+		return false
 	}
-	ec = curve
+	return lhs.P.Cmp(rhs.P) == 0 && lhs.N.Cmp(rhs.N) == 0 && lhs.Gx.Cmp(rhs.Gx) == 0 && lhs.Gy.Cmp(rhs.Gy) == 0
 }
 
 // secp256k1
@@ -100,6 +109,11 @@ func S256() elliptic.Curve {
 	return s256k1.S256()
 }
 
+// NIST P-256, also known as secp256r1
+func P256() elliptic.Curve {
+	return elliptic.P256()
+}
+
 func Edwards() elliptic.Curve {
 	return edwards.Edwards()
 }
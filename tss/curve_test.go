@@ -0,0 +1,31 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"crypto/elliptic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+func TestCurveNameOfAlias(t *testing.T) {
+	const alias tss.CurveName = "prime256v1"
+	tss.RegisterCurve(alias, elliptic.P256())
+
+	curve, ok := tss.GetCurveByName(alias)
+	assert.True(t, ok)
+	assert.True(t, tss.SameCurve(tss.P256(), curve))
+	// the name that was registered first is returned every time, as it is stored with points and save data
+	for i := 0; i < 100; i++ {
+		name, ok := tss.GetCurveName(curve)
+		assert.True(t, ok)
+		assert.Equal(t, tss.Secp256r1, name)
+	}
+}
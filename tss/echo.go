@@ -0,0 +1,251 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/SafeMPC/tss-lib/common"
+)
+
+// echoState tracks the echo-broadcast sub-round that follows each round in which broadcasts were received.
+// Once a round can proceed, the party broadcasts a digest of every broadcast it received in that round and waits for
+// the same from its peers. A sender that gave different peers different messages is caught when the digests disagree.
+// When the parties seal their messages, an echo also relays the signed envelope of each broadcast, so that the
+// disagreement is blamed on the sender if it signed two broadcasts, or on the echoer if it cannot prove its echo.
+type echoState struct {
+	initialised, enabled bool
+	// round -> sender index -> digest of the broadcast received from that sender
+	digests map[int]map[int][]byte
+	// round -> sender index -> envelope that the broadcast was received in, when the parties seal their messages
+	envelopes map[int]map[int][]byte
+	// round -> echoer index -> echo received from that party
+	echoes map[int]map[int]ParsedMessage
+	sent   map[int]bool
+}
+
+func (m *EchoMessage) ValidateBasic() bool {
+	return m != nil && 0 < m.GetRound()
+}
+
+func (m *EchoMessage) RoundNumber() int {
+	return int(m.GetRound())
+}
+
+// ----- //
+
+// echoEnabled reports whether the echo-broadcast sub-round is enabled in the party's parameters
+func echoEnabled(p Party) bool {
+	es := p.echoes()
+	if !es.initialised {
+		es.initialised = true
+		es.enabled = p.FirstRound().Params().EchoBroadcast()
+		es.digests = make(map[int]map[int][]byte)
+		es.envelopes = make(map[int]map[int][]byte)
+		es.echoes = make(map[int]map[int]ParsedMessage)
+		es.sent = make(map[int]bool)
+	}
+	return es.enabled
+}
+
+// recordBroadcast remembers the digest of a broadcast message so that it can be echoed once its round completes
+func recordBroadcast(p Party, msg ParsedMessage) {
+	round := messageRound(msg)
+	es := p.echoes()
+	if es.digests[round] == nil {
+		es.digests[round] = make(map[int][]byte)
+	}
+	if _, ok := es.digests[round][msg.GetFrom().Index]; ok {
+		return
+	}
+	es.digests[round][msg.GetFrom().Index] = broadcastDigest(msg)
+	if impl, ok := msg.(*MessageImpl); ok && impl.sealed && p.FirstRound().Params().authenticator != nil {
+		if es.envelopes[round] == nil {
+			es.envelopes[round] = make(map[int][]byte)
+		}
+		es.envelopes[round][msg.GetFrom().Index] = impl.received
+	}
+}
+
+// storeEcho stores an echo received from a peer; a peer may only send one echo per round
+func storeEcho(p Party, msg ParsedMessage) *Error {
+	echo := msg.Content().(*EchoMessage)
+	round, from := echo.RoundNumber(), msg.GetFrom().Index
+	es := p.echoes()
+	if es.echoes[round] == nil {
+		es.echoes[round] = make(map[int]ParsedMessage)
+	}
+	if prev, ok := es.echoes[round][from]; ok {
+		if proto.Equal(prev.Content(), echo) {
+			return nil
+		}
+		return p.WrapError(Classify(fmt.Errorf("received conflicting echoes for round %d", round), ErrEquivocation), msg.GetFrom()).
+			WithMessages(prev, msg)
+	}
+	es.echoes[round][from] = msg
+	return nil
+}
+
+// echoRound runs the echo sub-round of the current round once it can proceed.
+// It returns true when the party may advance: there were no broadcasts to echo or every peer's echo agrees with ours.
+func echoRound(p Party, out chan<- Message) (bool, *Error) {
+	rnd := p.round()
+	round := rnd.RoundNumber()
+	es := p.echoes()
+	digests := es.digests[round]
+	if len(digests) == 0 {
+		return true, nil
+	}
+	peers := rnd.Params().Parties().IDs()
+	if !es.sent[round] {
+		if out == nil {
+			return false, rnd.WrapError(errors.New("echo broadcast requires a party constructed with an out channel"))
+		}
+		content := &EchoMessage{Round: uint32(round), Digests: make([][]byte, len(peers))}
+		for idx, digest := range digests {
+			if idx < len(peers) {
+				content.Digests[idx] = digest
+			}
+		}
+		if envelopes := es.envelopes[round]; len(envelopes) > 0 {
+			content.Envelopes = make([][]byte, len(peers))
+			for idx, bz := range envelopes {
+				if idx < len(peers) {
+					content.Envelopes[idx] = relayedEnvelope(bz)
+				}
+			}
+		}
+		meta := MessageRouting{From: p.PartyID(), IsBroadcast: true}
+		es.sent[round] = true
+		out <- NewMessage(meta, content, NewMessageWrapper(meta, content))
+	}
+	if len(es.waitingFor(rnd)) > 0 {
+		return false, nil
+	}
+	for idx, sender := range peers {
+		digest, ok := digests[idx]
+		if !ok {
+			continue
+		}
+		for echoer, Pj := range peers {
+			msg, ok := es.echoes[round][echoer]
+			if !ok || echoer == idx {
+				continue
+			}
+			if echoed := msg.Content().(*EchoMessage).GetDigests(); len(echoed) <= idx || !bytes.Equal(echoed[idx], digest) {
+				return false, es.blame(rnd, sender, Pj, msg)
+			}
+		}
+	}
+	return true, nil
+}
+
+// blame returns the error for an echo of `echoer` that disagrees with the broadcast that this party received from
+// `sender`. If the echo relays an envelope of another broadcast that the sender signed for the round, the sender
+// equivocated. Otherwise the echoer is blamed, as an honest party only echoes a broadcast that it can prove was sent.
+// Without envelopes an equivocating sender cannot be told apart from a lying echoer, so both are blamed.
+func (es *echoState) blame(rnd Round, sender, echoer *PartyID, msg ParsedMessage) *Error {
+	round := rnd.RoundNumber()
+	received, signed := es.envelopes[round][sender.Index]
+	var err *Error
+	if !signed {
+		cause := fmt.Errorf("echo broadcast detected equivocation: %s echoed another broadcast of %s than this party received, "+
+			"and as the broadcasts are not signed either of them may have lied", echoer, sender)
+		err = rnd.WrapError(Classify(cause, ErrEquivocation), sender, echoer)
+	} else if relayErr := verifyRelayed(rnd.Params(), msg.Content().(*EchoMessage), sender, round, received); relayErr == nil {
+		cause := fmt.Errorf("echo broadcast detected equivocation: %s signed another broadcast for round %d than this party received", sender, round)
+		err = rnd.WrapError(Classify(cause, ErrEquivocation), sender)
+	} else {
+		cause := fmt.Errorf("echo broadcast detected equivocation: %s echoed a broadcast of %s that it cannot prove was sent: %w", echoer, sender, relayErr)
+		err = rnd.WrapError(Classify(cause, ErrEquivocation), echoer)
+	}
+	err.WithMessages(msg)
+	if signed {
+		err.WithEvidence(Evidence{From: sender, IsBroadcast: true, WireBytes: received, Sealed: true})
+	}
+	return err
+}
+
+// verifyRelayed checks that the echo relays an envelope of a broadcast for the round that the sender signed, other than
+// the one that this party received in the `received` envelope
+func verifyRelayed(params *Parameters, echo *EchoMessage, sender *PartyID, round int, received []byte) error {
+	envelopes := echo.GetEnvelopes()
+	if len(envelopes) <= sender.Index || len(envelopes[sender.Index]) == 0 {
+		return errors.New("the echo does not relay the envelope of the broadcast")
+	}
+	relayed, ours := new(Envelope), new(Envelope)
+	if err := proto.Unmarshal(envelopes[sender.Index], relayed); err != nil {
+		return fmt.Errorf("envelope: %w", err)
+	}
+	if err := proto.Unmarshal(received, ours); err != nil {
+		return fmt.Errorf("envelope: %w", err)
+	}
+	if !bytes.Equal(relayed.GetSessionId(), params.authenticator.sessionID) {
+		return errors.New("envelope: the message belongs to a different session")
+	}
+	if !relayed.GetIsBroadcast() || relayed.GetRound() != uint32(round) {
+		return fmt.Errorf("envelope: the message is not a broadcast for round %d", round)
+	}
+	if err := relayed.verifySignature(sender, params.authenticator.peers); err != nil {
+		return err
+	}
+	if bytes.Equal(relayed.payloadDigest(), ours.payloadDigest()) {
+		return errors.New("envelope: the message is the broadcast that this party received")
+	}
+	return nil
+}
+
+// relayedEnvelope returns the envelope without its payload, which is relayed in an echo in place of the broadcast, or
+// nil if the envelope cannot be unmarshalled
+func relayedEnvelope(bz []byte) []byte {
+	env := new(Envelope)
+	if err := proto.Unmarshal(bz, env); err != nil {
+		return nil
+	}
+	env.PayloadDigest, env.Payload = env.payloadDigest(), nil
+	relayed, err := proto.Marshal(env)
+	if err != nil {
+		return nil
+	}
+	return relayed
+}
+
+// waitingFor returns the peers whose echo for the current round has not arrived after ours was sent.
+// A peer whose broadcast is the only one in the round, as in a round in which a single party reveals something, has
+// nothing to echo and is not waited for.
+func (es *echoState) waitingFor(rnd Round) []*PartyID {
+	if es == nil || rnd == nil || !es.sent[rnd.RoundNumber()] {
+		return nil
+	}
+	self := rnd.Params().PartyID()
+	echoes, digests := es.echoes[rnd.RoundNumber()], es.digests[rnd.RoundNumber()]
+	missing := make([]*PartyID, 0)
+	for _, Pj := range rnd.Params().Parties().IDs() {
+		if Pj.Index == self.Index {
+			continue
+		}
+		if _, ok := digests[Pj.Index]; ok && len(digests) == 1 {
+			continue
+		}
+		if _, ok := echoes[Pj.Index]; !ok {
+			missing = append(missing, Pj)
+		}
+	}
+	return missing
+}
+
+func broadcastDigest(msg ParsedMessage) []byte {
+	bz, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg.Content())
+	if err != nil {
+		return nil
+	}
+	return common.SHA512_256([]byte(msg.Type()), bz)
+}
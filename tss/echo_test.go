@@ -0,0 +1,148 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/SafeMPC/tss-lib/eddsa/keygen"
+	"github.com/SafeMPC/tss-lib/test"
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+func TestRunWithEchoBroadcast(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(test.TestParticipants)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	results := runKeygen(ctx, t, pIDs, func(_ int, params *tss.Parameters) {
+		params.SetEchoBroadcast()
+	})
+	assert.Len(t, results, len(pIDs))
+	for _, res := range results {
+		if assert.Nil(t, res.err) && assert.NotNil(t, res.save) {
+			assert.True(t, res.save.EDDSAPub.Equals(results[0].save.EDDSAPub))
+		}
+	}
+}
+
+// runEchoKeygen runs an EdDSA keygen with echo broadcast in which `tamper` may replace a message on its way to a party;
+// it returns the errors of the parties that failed. With `seal` the parties seal their messages into signed envelopes.
+func runEchoKeygen(t *testing.T, pIDs tss.SortedPartyIDs, seal bool, tamper func(msg tss.Message, to *tss.PartyID) tss.Message) map[int]*tss.Error {
+	p2pCtx := tss.NewPeerContext(pIDs)
+	outCh := make(chan tss.Message, 100)
+	var keys []ed25519.PrivateKey
+	if seal {
+		keys = identities(t, pIDs)
+	}
+	parties := make([]*keygen.LocalParty, len(pIDs))
+	for i, pID := range pIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pID, len(pIDs), 1)
+		params.SetEchoBroadcast()
+		if seal {
+			params.SetAuthenticator(newAuthenticator(t, []byte("session"), keys[i], pIDs))
+		}
+		parties[i] = keygen.NewLocalParty(params, outCh, make(chan *keygen.LocalPartySaveData, 1)).(*keygen.LocalParty)
+		if !assert.Nil(t, parties[i].Start()) {
+			t.FailNow()
+		}
+	}
+
+	errs := make(map[int]*tss.Error)
+	deliver := func(msg tss.Message, to *tss.PartyID) {
+		if _, ok := errs[to.Index]; ok {
+			return
+		}
+		msg = tamper(msg, to)
+		sealed, err := parties[msg.GetFrom().Index].FirstRound().Params().SealWireMessage(msg)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		if _, err := parties[to.Index].UpdateFromBytes(wireBytes(t, sealed), msg.GetFrom(), msg.IsBroadcast()); err != nil {
+			errs[to.Index] = err
+		}
+	}
+	for len(outCh) > 0 && len(errs) < len(pIDs)-1 {
+		msg := <-outCh
+		if msg.IsBroadcast() {
+			for _, pID := range pIDs {
+				if pID != msg.GetFrom() {
+					deliver(msg, pID)
+				}
+			}
+			continue
+		}
+		for _, pID := range msg.GetTo() {
+			deliver(msg, pID)
+		}
+	}
+	return errs
+}
+
+func TestEchoBroadcastDetectsEquivocation(t *testing.T) {
+	for _, seal := range []bool{true, false} {
+		pIDs := tss.GenerateTestPartyIDs(3)
+		liar, victim := pIDs[0], pIDs[2]
+		errs := runEchoKeygen(t, pIDs, seal, func(msg tss.Message, to *tss.PartyID) tss.Message {
+			if _, ok := msg.(tss.ParsedMessage).Content().(*keygen.KGRound1Message); ok && msg.GetFrom() == liar && to == victim {
+				// the liar sends the victim a different commitment than everyone else
+				return keygen.NewKGRound1Message(liar, big.NewInt(1))
+			}
+			return msg
+		})
+		for _, pID := range pIDs[1:] {
+			if err, ok := errs[pID.Index]; assert.True(t, ok, "party %d should have detected the equivocation", pID.Index) {
+				assert.ErrorIs(t, err, tss.ErrEquivocation)
+				assert.NotEmpty(t, err.Evidence())
+				if seal {
+					// the echo relays the other broadcast that the liar signed
+					assert.Equal(t, []*tss.PartyID{liar}, err.Culprits())
+					continue
+				}
+				// without signatures an equivocating broadcaster cannot be told apart from a lying echoer
+				assert.Len(t, err.Culprits(), 2)
+				assert.Contains(t, err.Culprits(), liar)
+			}
+		}
+	}
+}
+
+func TestEchoBroadcastLyingEchoer(t *testing.T) {
+	for _, seal := range []bool{true, false} {
+		pIDs := tss.GenerateTestPartyIDs(3)
+		honest, liar := pIDs[0], pIDs[1]
+		errs := runEchoKeygen(t, pIDs, seal, func(msg tss.Message, to *tss.PartyID) tss.Message {
+			echo, ok := msg.(tss.ParsedMessage).Content().(*tss.EchoMessage)
+			if !ok || msg.GetFrom() != liar {
+				return msg
+			}
+			// the liar echoes another broadcast of the honest party than the one that it sent
+			lie := proto.Clone(echo).(*tss.EchoMessage)
+			lie.Digests[honest.Index] = make([]byte, len(echo.Digests[honest.Index]))
+			meta := tss.MessageRouting{From: liar, IsBroadcast: true}
+			return tss.NewMessage(meta, lie, tss.NewMessageWrapper(meta, lie))
+		})
+		if assert.NotEmpty(t, errs) {
+			for _, err := range errs {
+				assert.ErrorIs(t, err, tss.ErrEquivocation)
+				if seal {
+					// the liar cannot relay an envelope of another broadcast that the honest party signed
+					assert.Equal(t, []*tss.PartyID{liar}, err.Culprits(), "the honest broadcaster must not be framed")
+					continue
+				}
+				assert.ElementsMatch(t, []*tss.PartyID{honest, liar}, err.Culprits())
+			}
+		}
+	}
+}
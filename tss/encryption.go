@@ -0,0 +1,141 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss
+
+import (
+	"crypto/cipher"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/hkdf"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/SafeMPC/tss-lib/common"
+)
+
+var encryptionDomain = []byte("tss-lib p2p encryption v1")
+
+// encryptedMessage is a point-to-point Message whose wire bytes are encrypted to its recipient
+type encryptedMessage struct {
+	Message
+	wireBytes []byte
+}
+
+// GenerateEncryptionKey returns a new X25519 key pair for encrypting point-to-point messages.
+// The public key should be set as the EncryptionKey of the party's PartyID on every peer.
+func GenerateEncryptionKey(rand io.Reader) (privateKey, publicKey []byte, err error) {
+	privateKey = make([]byte, curve25519.ScalarSize)
+	if _, err = io.ReadFull(rand, privateKey); err != nil {
+		return nil, nil, err
+	}
+	if publicKey, err = curve25519.X25519(privateKey, curve25519.Basepoint); err != nil {
+		return nil, nil, err
+	}
+	return privateKey, publicKey, nil
+}
+
+// encryptMessage encrypts the wire bytes of a point-to-point message to its single recipient
+func encryptMessage(privateKey, sessionID []byte, msg Message, rand io.Reader) (Message, error) {
+	if msg.IsBroadcast() {
+		return msg, nil
+	}
+	payload, routing, err := msg.WireBytes()
+	if err != nil {
+		return nil, err
+	}
+	if len(routing.To) != 1 {
+		return nil, fmt.Errorf("cannot encrypt a point-to-point message with %d recipients", len(routing.To))
+	}
+	aead, err := pairwiseAEAD(privateKey, routing.From, routing.To[0], routing.To[0].EncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = io.ReadFull(rand, nonce); err != nil {
+		return nil, err
+	}
+	any, err := anypb.New(&EncryptedMessage{
+		Nonce:      nonce,
+		Ciphertext: aead.Seal(nil, nonce, payload, pairwiseAD(sessionID, routing.From, routing.To[0])),
+	})
+	if err != nil {
+		return nil, err
+	}
+	bz, err := proto.Marshal(any)
+	if err != nil {
+		return nil, err
+	}
+	return &encryptedMessage{Message: msg, wireBytes: bz}, nil
+}
+
+// decryptPayload returns the plaintext wire bytes of a message encrypted by `from` to `self`.
+// ok is false if the payload was not encrypted.
+func decryptPayload(privateKey, sessionID, payload []byte, from, self *PartyID) (plaintext []byte, ok bool, err error) {
+	any := new(anypb.Any)
+	if err = proto.Unmarshal(payload, any); err != nil {
+		return nil, false, err
+	}
+	enc := new(EncryptedMessage)
+	if !any.MessageIs(enc) {
+		return payload, false, nil
+	}
+	if err = any.UnmarshalTo(enc); err != nil {
+		return nil, true, err
+	}
+	aead, err := pairwiseAEAD(privateKey, from, self, from.EncryptionKey)
+	if err != nil {
+		return nil, true, err
+	}
+	if len(enc.GetNonce()) != aead.NonceSize() {
+		return nil, true, errors.New("encrypted message has an invalid nonce")
+	}
+	plaintext, err = aead.Open(nil, enc.GetNonce(), enc.GetCiphertext(), pairwiseAD(sessionID, from, self))
+	if err != nil {
+		return nil, true, errors.New("encrypted message could not be decrypted")
+	}
+	return plaintext, true, nil
+}
+
+// pairwiseAEAD derives the ChaCha20-Poly1305 key shared by `from` and `to` from the X25519 exchange of our private key
+// with the peer's public key; both ends derive the same key.
+func pairwiseAEAD(privateKey []byte, from, to *PartyID, peerPublicKey []byte) (cipher.AEAD, error) {
+	if len(peerPublicKey) != curve25519.PointSize {
+		return nil, errors.New("the peer does not have a valid encryption key")
+	}
+	shared, err := curve25519.X25519(privateKey, peerPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	info := append(append(append([]byte{}, encryptionDomain...), from.EncryptionKey...), to.EncryptionKey...)
+	key := make([]byte, chacha20poly1305.KeySize)
+	if _, err = io.ReadFull(hkdf.New(sha256.New, shared, nil, info), key); err != nil {
+		return nil, err
+	}
+	return chacha20poly1305.New(key)
+}
+
+// pairwiseAD binds the ciphertext to the session, the sender and the recipient
+func pairwiseAD(sessionID []byte, from, to *PartyID) []byte {
+	return common.SHA512_256(sessionID, from.Key, to.Key)
+}
+
+func (em *encryptedMessage) WireBytes() ([]byte, *MessageRouting, error) {
+	_, routing, err := em.Message.WireBytes()
+	if err != nil {
+		return nil, nil, err
+	}
+	return em.wireBytes, routing, nil
+}
+
+func (em *encryptedMessage) unwrap() Message {
+	return em.Message
+}
@@ -0,0 +1,141 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SafeMPC/tss-lib/crypto/vss"
+	"github.com/SafeMPC/tss-lib/eddsa/keygen"
+	"github.com/SafeMPC/tss-lib/test"
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+// encryptionKeys assigns a fresh encryption key pair to every party
+func encryptionKeys(t *testing.T, pIDs tss.SortedPartyIDs) [][]byte {
+	keys := make([][]byte, len(pIDs))
+	for i, pID := range pIDs {
+		sk, pk, err := tss.GenerateEncryptionKey(rand.Reader)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		pID.EncryptionKey = pk
+		keys[i] = sk
+	}
+	return keys
+}
+
+func TestRunWithEncryption(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(test.TestParticipants)
+	encKeys := encryptionKeys(t, pIDs)
+	idKeys := identities(t, pIDs)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	results := runKeygen(ctx, t, pIDs, func(i int, params *tss.Parameters) {
+		params.SetEncryptionKey(encKeys[i])
+		params.SetAuthenticator(newAuthenticator(t, []byte("session"), idKeys[i], pIDs))
+	})
+	assert.Len(t, results, len(pIDs))
+	for _, res := range results {
+		assert.Nil(t, res.err)
+	}
+}
+
+func TestEncryptPointToPoint(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(3)
+	encKeys := encryptionKeys(t, pIDs)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	params := make([]*tss.Parameters, len(pIDs))
+	for i, pID := range pIDs {
+		params[i] = tss.NewParameters(tss.Edwards(), p2pCtx, pID, len(pIDs), 1)
+		params[i].SetEncryptionKey(encKeys[i])
+	}
+
+	share := &vss.Share{Threshold: 1, ID: big.NewInt(1), Share: new(big.Int).SetBytes([]byte("a very secret shamir share"))}
+	plain := keygen.NewKGRound2Message1(pIDs[1], pIDs[0], share)
+	sealed, err := params[0].SealWireMessage(plain)
+	assert.NoError(t, err)
+	bz := wireBytes(t, sealed)
+	assert.False(t, bytes.Contains(bz, share.Share.Bytes()), "the share must not be sent in the clear")
+
+	msg, err := params[1].ParseWireMessage(bz, pIDs[0], false)
+	if assert.NoError(t, err) {
+		assert.Equal(t, share.Share.Bytes(), msg.Content().(*keygen.KGRound2Message1).GetShare())
+	}
+
+	// only the recipient can decrypt it
+	_, err = params[2].ParseWireMessage(bz, pIDs[0], false)
+	assert.Error(t, err)
+
+	// plaintext point-to-point messages are refused once encryption is enabled
+	_, err = params[1].ParseWireMessage(wireBytes(t, plain), pIDs[0], false)
+	assert.Error(t, err)
+
+	// broadcasts are left as they are
+	broadcast := keygen.NewKGRound1Message(pIDs[0], big.NewInt(1))
+	sealed, err = params[0].SealWireMessage(broadcast)
+	assert.NoError(t, err)
+	assert.Equal(t, wireBytes(t, broadcast), wireBytes(t, sealed))
+}
+
+func TestEvidenceStaysEncrypted(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(3)
+	encKeys := encryptionKeys(t, pIDs)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	params := make([]*tss.Parameters, len(pIDs))
+	for i, pID := range pIDs {
+		params[i] = tss.NewParameters(tss.Edwards(), p2pCtx, pID, len(pIDs), 1)
+		params[i].SetEncryptionKey(encKeys[i])
+	}
+	P := keygen.NewLocalParty(params[1], make(chan tss.Message, len(pIDs)*len(pIDs)), nil)
+	if !assert.Nil(t, P.Start()) {
+		return
+	}
+
+	// party 0 sends party 1 two different shares
+	var sent [][]byte
+	var secrets [][]byte
+	var err *tss.Error
+	for _, secret := range []string{"a very secret shamir share", "another very secret share"} {
+		share := &vss.Share{Threshold: 1, ID: big.NewInt(2), Share: new(big.Int).SetBytes([]byte(secret))}
+		sealed, sErr := params[0].SealWireMessage(keygen.NewKGRound2Message1(pIDs[1], pIDs[0], share))
+		assert.NoError(t, sErr)
+		bz := wireBytes(t, sealed)
+		sent, secrets = append(sent, bz), append(secrets, share.Share.Bytes())
+		_, err = P.UpdateFromBytes(bz, pIDs[0], false)
+	}
+	if !assert.NotNil(t, err) {
+		return
+	}
+	assert.ErrorIs(t, err, tss.ErrEquivocation)
+
+	// the evidence is the ciphertext that was received, not the shares that it decrypted to
+	report, jErr := json.Marshal(err.Report())
+	assert.NoError(t, jErr)
+	for _, secret := range secrets {
+		assert.False(t, bytes.Contains(report, secret), "a decrypted share must not be exported")
+		assert.False(t, bytes.Contains(report, []byte(base64.StdEncoding.EncodeToString(secret))), "a decrypted share must not be exported")
+	}
+	if assert.Len(t, err.Evidence(), 2) {
+		for i, ev := range err.Evidence() {
+			assert.Equal(t, sent[i], ev.WireBytes)
+			assert.True(t, ev.Sealed)
+			_, pErr := ev.Message()
+			assert.Error(t, pErr)
+		}
+	}
+}
@@ -0,0 +1,274 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/SafeMPC/tss-lib/common"
+)
+
+var envelopeDomain = []byte("tss-lib envelope v2")
+
+type (
+	// Authenticator seals the messages of one party into signed envelopes and opens the envelopes it receives.
+	// Each envelope binds the session ID, the round, a per-sender sequence number and the recipients, so forged,
+	// replayed, redirected or cross-session messages are rejected before they reach the party's rounds.
+	// An Authenticator holds replay state and must not be shared between parties.
+	Authenticator struct {
+		sessionID []byte
+		identity  ed25519.PrivateKey
+		peers     map[string]ed25519.PublicKey
+
+		mtx      sync.Mutex
+		sequence uint64
+		seen     map[string]map[envelopeSlot]struct{}
+	}
+
+	envelopeSlot struct {
+		round    uint32
+		sequence uint64
+	}
+
+	// sealedMessage is a Message whose wire bytes are an authenticated envelope
+	sealedMessage struct {
+		Message
+		wireBytes []byte
+	}
+)
+
+// NewAuthenticator creates an Authenticator for a party holding the `identity` signing key.
+// `peers` are the parties whose messages may be opened; each must carry its public IdentityKey.
+// During re-sharing this is the union of the old and new committees.
+func NewAuthenticator(sessionID []byte, identity ed25519.PrivateKey, peers ...*PartyID) (*Authenticator, error) {
+	if len(identity) != ed25519.PrivateKeySize {
+		return nil, errors.New("NewAuthenticator: the identity key is not a valid ed25519 private key")
+	}
+	keys, err := identityKeys(peers)
+	if err != nil {
+		return nil, fmt.Errorf("NewAuthenticator: %w", err)
+	}
+	return &Authenticator{
+		sessionID: sessionID,
+		identity:  identity,
+		peers:     keys,
+		seen:      make(map[string]map[envelopeSlot]struct{}),
+	}, nil
+}
+
+func (a *Authenticator) SessionID() []byte {
+	return a.sessionID
+}
+
+// Seal wraps an outbound message in a signed envelope.
+// The returned Message routes exactly like msg but its WireBytes() are the envelope.
+func (a *Authenticator) Seal(msg Message) (Message, error) {
+	payload, routing, err := msg.WireBytes()
+	if err != nil {
+		return nil, err
+	}
+	a.mtx.Lock()
+	a.sequence++
+	seq := a.sequence
+	a.mtx.Unlock()
+
+	env := &Envelope{
+		SessionId:   a.sessionID,
+		Round:       uint32(messageRound(msg)),
+		Sequence:    seq,
+		From:        routing.From.Key,
+		IsBroadcast: routing.IsBroadcast,
+		Payload:     payload,
+	}
+	if !routing.IsBroadcast {
+		for _, pID := range routing.To {
+			env.To = append(env.To, pID.Key)
+		}
+	}
+	env.Signature = ed25519.Sign(a.identity, env.digest())
+	bz, err := proto.Marshal(env)
+	if err != nil {
+		return nil, err
+	}
+	return &sealedMessage{Message: msg, wireBytes: bz}, nil
+}
+
+// Open verifies an inbound envelope received by `self` from `from` and parses the message that it carries.
+// Envelopes with a bad signature, from another session, addressed to another party, or seen before are rejected.
+func (a *Authenticator) Open(wireBytes []byte, from, self *PartyID, isBroadcast bool) (ParsedMessage, error) {
+	env, err := a.open(wireBytes, from, self, isBroadcast)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := ParseWireMessage(env.GetPayload(), from, env.GetIsBroadcast())
+	if err != nil {
+		return nil, err
+	}
+	if err = a.accept(env, msg, from); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// open unmarshals and verifies an envelope without parsing its payload
+func (a *Authenticator) open(wireBytes []byte, from, self *PartyID, isBroadcast bool) (*Envelope, error) {
+	env := new(Envelope)
+	if err := proto.Unmarshal(wireBytes, env); err != nil {
+		return nil, fmt.Errorf("envelope: %w", err)
+	}
+	if !bytes.Equal(env.GetSessionId(), a.sessionID) {
+		return nil, errors.New("envelope: the message belongs to a different session")
+	}
+	// an envelope relayed in an echo has a valid signature but no payload, and must not be taken for a message
+	if len(env.GetPayload()) == 0 {
+		return nil, errors.New("envelope: the message has no payload")
+	}
+	if err := env.verifySignature(from, a.peers); err != nil {
+		return nil, err
+	}
+	if env.GetIsBroadcast() != isBroadcast {
+		return nil, errors.New("envelope: the message was not delivered the way it was sent")
+	}
+	if !env.GetIsBroadcast() && !env.addressedTo(self) {
+		return nil, errors.New("envelope: the message is addressed to another party")
+	}
+	return env, nil
+}
+
+// accept checks that the parsed message matches the round bound into its envelope and that it was not seen before
+func (a *Authenticator) accept(env *Envelope, msg ParsedMessage, from *PartyID) error {
+	if round := messageRound(msg); round != int(env.GetRound()) {
+		return fmt.Errorf("envelope: the message is for round %d but the envelope is bound to round %d", round, env.GetRound())
+	}
+	if !a.markSeen(from, envelopeSlot{env.GetRound(), env.GetSequence()}) {
+		// anyone can replay an envelope, so its sender is not to blame
+		return unauthenticatedError{errors.New("envelope: the message is a replay")}
+	}
+	return nil
+}
+
+func (a *Authenticator) markSeen(from *PartyID, slot envelopeSlot) bool {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	seen, ok := a.seen[string(from.Key)]
+	if !ok {
+		seen = make(map[envelopeSlot]struct{})
+		a.seen[string(from.Key)] = seen
+	}
+	if _, dup := seen[slot]; dup {
+		return false
+	}
+	seen[slot] = struct{}{}
+	return true
+}
+
+// identityKeys maps the keys of the peers to their identity keys, which each must have
+func identityKeys(peers []*PartyID) (map[string]ed25519.PublicKey, error) {
+	keys := make(map[string]ed25519.PublicKey, len(peers))
+	for _, pID := range peers {
+		if pID == nil || pID.MessageWrapper_PartyID == nil || len(pID.IdentityKey) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("party %v does not have a valid identity key", pID)
+		}
+		keys[string(pID.Key)] = pID.IdentityKey
+	}
+	return keys, nil
+}
+
+// unauthenticatedError is a failure to receive a message before the signature of its envelope checked out, or of an
+// envelope that was replayed. The sender that the transport reported cannot be blamed for it, as anyone could have
+// sent the bytes in its name.
+type unauthenticatedError struct {
+	error
+}
+
+func (err unauthenticatedError) Unwrap() error { return err.error }
+
+// ----- //
+
+func (env *Envelope) digest() []byte {
+	header := make([]byte, 4+8+1)
+	binary.BigEndian.PutUint32(header, env.GetRound())
+	binary.BigEndian.PutUint64(header[4:], env.GetSequence())
+	if env.GetIsBroadcast() {
+		header[12] = 1
+	}
+	in := [][]byte{envelopeDomain, env.GetSessionId(), header, env.GetFrom(), env.payloadDigest()}
+	in = append(in, env.GetTo()...)
+	return common.SHA512_256(in...)
+}
+
+// payloadDigest returns the digest of the payload, which the signature covers instead of the payload so that the
+// envelope of a broadcast can be relayed in an echo without it
+func (env *Envelope) payloadDigest() []byte {
+	if len(env.GetPayload()) == 0 {
+		return env.GetPayloadDigest()
+	}
+	return common.SHA512_256(env.GetPayload())
+}
+
+// verifySignature checks that the envelope was sent by `from`, one of the peers with the given identity keys, and
+// that it was signed by it
+func (env *Envelope) verifySignature(from *PartyID, peers map[string]ed25519.PublicKey) error {
+	if !bytes.Equal(env.GetFrom(), from.Key) {
+		return errors.New("envelope: the message was not sent by the party it was received from")
+	}
+	pk, ok := peers[string(from.Key)]
+	if !ok {
+		return errors.New("envelope: the sender is not a known peer")
+	}
+	if !ed25519.Verify(pk, env.digest(), env.GetSignature()) {
+		return errors.New("envelope: signature verification failed")
+	}
+	return nil
+}
+
+func (env *Envelope) addressedTo(pID *PartyID) bool {
+	for _, to := range env.GetTo() {
+		if bytes.Equal(to, pID.Key) {
+			return true
+		}
+	}
+	return false
+}
+
+// messageRound returns the round of a message's content, or 0 if it is not bound to a round
+func messageRound(msg Message) int {
+	for {
+		wrapper, ok := msg.(interface{ unwrap() Message })
+		if !ok {
+			break
+		}
+		msg = wrapper.unwrap()
+	}
+	if parsed, ok := msg.(ParsedMessage); ok {
+		if content, ok := parsed.Content().(RoundContent); ok {
+			return content.RoundNumber()
+		}
+	}
+	return 0
+}
+
+// ----- //
+
+func (sm *sealedMessage) WireBytes() ([]byte, *MessageRouting, error) {
+	_, routing, err := sm.Message.WireBytes()
+	if err != nil {
+		return nil, nil, err
+	}
+	return sm.wireBytes, routing, nil
+}
+
+func (sm *sealedMessage) unwrap() Message {
+	return sm.Message
+}
@@ -0,0 +1,166 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SafeMPC/tss-lib/crypto/vss"
+	"github.com/SafeMPC/tss-lib/eddsa/keygen"
+	"github.com/SafeMPC/tss-lib/test"
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+// identities assigns a fresh identity key to every party
+func identities(t *testing.T, pIDs tss.SortedPartyIDs) []ed25519.PrivateKey {
+	keys := make([]ed25519.PrivateKey, len(pIDs))
+	for i, pID := range pIDs {
+		pk, sk, err := ed25519.GenerateKey(rand.Reader)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		pID.IdentityKey = pk
+		keys[i] = sk
+	}
+	return keys
+}
+
+func newAuthenticator(t *testing.T, sessionID []byte, key ed25519.PrivateKey, pIDs tss.SortedPartyIDs) *tss.Authenticator {
+	auth, err := tss.NewAuthenticator(sessionID, key, pIDs...)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return auth
+}
+
+func wireBytes(t *testing.T, msg tss.Message) []byte {
+	bz, _, err := msg.WireBytes()
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return bz
+}
+
+func TestRunWithAuthenticator(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(test.TestParticipants)
+	keys := identities(t, pIDs)
+	sessionID := []byte("session")
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	results := runKeygen(ctx, t, pIDs, func(i int, params *tss.Parameters) {
+		params.SetAuthenticator(newAuthenticator(t, sessionID, keys[i], pIDs))
+	})
+	assert.Len(t, results, len(pIDs))
+	for _, res := range results {
+		assert.Nil(t, res.err)
+	}
+}
+
+func TestAuthenticatorOpen(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(3)
+	keys := identities(t, pIDs)
+	sender := newAuthenticator(t, []byte("session"), keys[0], pIDs)
+	broadcast := keygen.NewKGRound1Message(pIDs[0], big.NewInt(1))
+
+	sealed, err := sender.Seal(broadcast)
+	assert.NoError(t, err)
+	bz := wireBytes(t, sealed)
+
+	receiver := newAuthenticator(t, []byte("session"), keys[1], pIDs)
+	msg, err := receiver.Open(bz, pIDs[0], pIDs[1], true)
+	if assert.NoError(t, err) {
+		assert.Equal(t, broadcast.Type(), msg.Type())
+	}
+
+	// replayed
+	_, err = receiver.Open(bz, pIDs[0], pIDs[1], true)
+	assert.Error(t, err)
+
+	// claimed to come from another party
+	_, err = newAuthenticator(t, []byte("session"), keys[1], pIDs).Open(bz, pIDs[2], pIDs[1], true)
+	assert.Error(t, err)
+
+	// another session
+	_, err = newAuthenticator(t, []byte("other session"), keys[1], pIDs).Open(bz, pIDs[0], pIDs[1], true)
+	assert.Error(t, err)
+
+	// signed with the wrong identity key
+	forger := newAuthenticator(t, []byte("session"), keys[2], pIDs)
+	forged, err := forger.Seal(broadcast)
+	assert.NoError(t, err)
+	_, err = newAuthenticator(t, []byte("session"), keys[1], pIDs).Open(wireBytes(t, forged), pIDs[0], pIDs[1], true)
+	assert.Error(t, err)
+
+	// a point-to-point message redirected to another party
+	share := &vss.Share{Threshold: 1, ID: big.NewInt(1), Share: big.NewInt(42)}
+	p2p, err := sender.Seal(keygen.NewKGRound2Message1(pIDs[1], pIDs[0], share))
+	assert.NoError(t, err)
+	_, err = newAuthenticator(t, []byte("session"), keys[2], pIDs).Open(wireBytes(t, p2p), pIDs[0], pIDs[2], false)
+	assert.Error(t, err)
+	_, err = newAuthenticator(t, []byte("session"), keys[1], pIDs).Open(wireBytes(t, p2p), pIDs[0], pIDs[1], false)
+	assert.NoError(t, err)
+}
+
+func TestUpdateFromBytesRejectsForgedEnvelope(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(3)
+	keys := identities(t, pIDs)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[1], len(pIDs), 1)
+	params.SetAuthenticator(newAuthenticator(t, []byte("session"), keys[1], pIDs))
+	P := keygen.NewLocalParty(params, make(chan tss.Message, len(pIDs)), make(chan *keygen.LocalPartySaveData, 1))
+	if !assert.Nil(t, P.Start()) {
+		return
+	}
+
+	forger := newAuthenticator(t, []byte("session"), keys[2], pIDs)
+	forged, err := forger.Seal(keygen.NewKGRound1Message(pIDs[0], big.NewInt(1)))
+	assert.NoError(t, err)
+	ok, tErr := P.UpdateFromBytes(wireBytes(t, forged), pIDs[0], true)
+	assert.False(t, ok)
+	if assert.NotNil(t, tErr) {
+		// the forger signed in the name of pIDs[0], which must not be blamed
+		assert.ErrorIs(t, tErr, tss.ErrBadMessage)
+		assert.Empty(t, tErr.Culprits())
+		assert.Len(t, tErr.Evidence(), 1)
+	}
+
+	// a replayed envelope is authentic, but anyone can replay it
+	sealed, err := newAuthenticator(t, []byte("session"), keys[0], pIDs).Seal(keygen.NewKGRound1Message(pIDs[0], big.NewInt(1)))
+	assert.NoError(t, err)
+	ok, tErr = P.UpdateFromBytes(wireBytes(t, sealed), pIDs[0], true)
+	assert.True(t, ok)
+	assert.Nil(t, tErr)
+	ok, tErr = P.UpdateFromBytes(wireBytes(t, sealed), pIDs[0], true)
+	assert.False(t, ok)
+	if assert.NotNil(t, tErr) {
+		assert.Empty(t, tErr.Culprits())
+	}
+
+	// an authentic but malformed message is kept as evidence in its envelope, which anyone in the session can verify
+	meta, empty := tss.MessageRouting{From: pIDs[2], IsBroadcast: true}, &keygen.KGRound1Message{}
+	malformed, err := newAuthenticator(t, []byte("session"), keys[2], pIDs).Seal(tss.NewMessage(meta, empty, tss.NewMessageWrapper(meta, empty)))
+	assert.NoError(t, err)
+	_, tErr = P.UpdateFromBytes(wireBytes(t, malformed), pIDs[2], true)
+	if assert.NotNil(t, tErr) {
+		assert.ErrorIs(t, tErr, tss.ErrBadMessage)
+		if assert.Len(t, tErr.Evidence(), 1) {
+			ev := tErr.Evidence()[0]
+			assert.Equal(t, wireBytes(t, malformed), ev.WireBytes)
+			assert.True(t, ev.Sealed)
+			_, err = newAuthenticator(t, []byte("session"), keys[0], pIDs).Open(ev.WireBytes, ev.From, pIDs[0], ev.IsBroadcast)
+			assert.NoError(t, err)
+		}
+	}
+}
@@ -7,22 +7,111 @@
 package tss
 
 import (
+	"errors"
 	"fmt"
 )
 
-// fundamental is an error that has a message and a stack, but no caller.
-type Error struct {
-	cause    error
-	task     string
-	round    int
-	victim   *PartyID
-	culprits []*PartyID
+// ErrorCode is a stable, machine-readable classification of why a protocol aborted
+type ErrorCode string
+
+const (
+	CodeUnknown            ErrorCode = "unknown"
+	CodeInvalidProof       ErrorCode = "invalid_proof"
+	CodeCommitmentMismatch ErrorCode = "commitment_mismatch"
+	CodeVSSFailure         ErrorCode = "vss_failure"
+	CodeBadMessage         ErrorCode = "bad_message"
+	CodeTimeout            ErrorCode = "timeout"
+	CodeEquivocation       ErrorCode = "equivocation"
+	CodeInvalidParameters  ErrorCode = "invalid_parameters"
+	CodeVersionMismatch    ErrorCode = "version_mismatch"
+	CodeInboxFull          ErrorCode = "inbox_full"
+	CodeAborted            ErrorCode = "aborted"
+	CodeFalseComplaint     ErrorCode = "false_complaint"
+)
+
+// Sentinel errors that classify the cause of an Error; test for them with errors.Is
+var (
+	ErrInvalidProof       = errors.New("invalid zero-knowledge proof")
+	ErrCommitmentMismatch = errors.New("commitment mismatch")
+	ErrVSSFailure         = errors.New("vss share verification failed")
+	ErrBadMessage         = errors.New("bad message format")
+	ErrTimeout            = errors.New("timed out")
+	ErrEquivocation       = errors.New("equivocation")
+	ErrInvalidParameters  = errors.New("invalid parameters")
+	ErrVersionMismatch    = errors.New("incompatible protocol version")
+	ErrInboxFull          = errors.New("party inbox is full")
+	ErrAborted            = errors.New("party was aborted")
+	ErrFalseComplaint     = errors.New("complaint about a valid share")
+)
+
+var errorCodes = []struct {
+	sentinel error
+	code     ErrorCode
+}{
+	{ErrEquivocation, CodeEquivocation},
+	{ErrInvalidProof, CodeInvalidProof},
+	{ErrCommitmentMismatch, CodeCommitmentMismatch},
+	{ErrVSSFailure, CodeVSSFailure},
+	{ErrBadMessage, CodeBadMessage},
+	{ErrTimeout, CodeTimeout},
+	{ErrInvalidParameters, CodeInvalidParameters},
+	{ErrVersionMismatch, CodeVersionMismatch},
+	{ErrInboxFull, CodeInboxFull},
+	{ErrAborted, CodeAborted},
+	{ErrFalseComplaint, CodeFalseComplaint},
 }
 
+type (
+	// fundamental is an error that has a message and a stack, but no caller.
+	Error struct {
+		cause    error
+		task     string
+		round    int
+		victim   *PartyID
+		culprits []*PartyID
+		evidence []Evidence
+	}
+
+	// classifiedError attaches a sentinel to an error without changing its message
+	classifiedError struct {
+		err, sentinel error
+	}
+
+	// Evidence is a message received from a culprit, as it was received on the wire.
+	// Sealed evidence is an envelope or an encrypted message, which must be opened by its recipient to be checked.
+	Evidence struct {
+		From        *PartyID `json:"from"`
+		IsBroadcast bool     `json:"isBroadcast"`
+		WireBytes   []byte   `json:"wireBytes"`
+		Sealed      bool     `json:"sealed,omitempty"`
+	}
+
+	// AbortReport is a serializable account of an Error, with the messages that the culprits are blamed for as evidence.
+	// It can be marshalled to JSON and shown to the other parties or to an auditor.
+	AbortReport struct {
+		Code     ErrorCode  `json:"code"`
+		Task     string     `json:"task"`
+		Round    int        `json:"round"`
+		Victim   *PartyID   `json:"victim,omitempty"`
+		Culprits []*PartyID `json:"culprits,omitempty"`
+		Reason   string     `json:"reason"`
+		Evidence []Evidence `json:"evidence,omitempty"`
+	}
+)
+
 func NewError(err error, task string, round int, victim *PartyID, culprits ...*PartyID) *Error {
 	return &Error{cause: err, task: task, round: round, victim: victim, culprits: culprits}
 }
 
+// Classify marks err with one of the sentinel errors, such as ErrInvalidProof, so that errors.Is matches it.
+// The message of err is unchanged.
+func Classify(err, sentinel error) error {
+	if err == nil {
+		return nil
+	}
+	return &classifiedError{err: err, sentinel: sentinel}
+}
+
 func (err *Error) Unwrap() error { return err.cause }
 
 func (err *Error) Cause() error { return err.cause }
@@ -35,6 +124,54 @@ func (err *Error) Victim() *PartyID { return err.victim }
 
 func (err *Error) Culprits() []*PartyID { return err.culprits }
 
+func (err *Error) Evidence() []Evidence { return err.evidence }
+
+// WithEvidence adds messages that the culprits are blamed for to the Error and returns it
+func (err *Error) WithEvidence(evidence ...Evidence) *Error {
+	err.evidence = append(err.evidence, evidence...)
+	return err
+}
+
+// WithMessages adds the messages that the culprits are blamed for to the Error as evidence, in the form that they were
+// received in, and returns it. Messages that are nil or cannot be recorded are skipped.
+func (err *Error) WithMessages(msgs ...Message) *Error {
+	for _, msg := range msgs {
+		if msg == nil {
+			continue
+		}
+		if ev, evErr := NewEvidence(msg); evErr == nil {
+			err.evidence = append(err.evidence, ev)
+		}
+	}
+	return err
+}
+
+// Code classifies the cause of the Error by the sentinel errors that it wraps
+func (err *Error) Code() ErrorCode {
+	for _, c := range errorCodes {
+		if errors.Is(err.cause, c.sentinel) {
+			return c.code
+		}
+	}
+	return CodeUnknown
+}
+
+// Report returns a serializable AbortReport of the Error
+func (err *Error) Report() *AbortReport {
+	report := &AbortReport{
+		Code:     err.Code(),
+		Task:     err.task,
+		Round:    err.round,
+		Victim:   err.victim,
+		Culprits: err.culprits,
+		Evidence: err.evidence,
+	}
+	if err.cause != nil {
+		report.Reason = err.cause.Error()
+	}
+	return report
+}
+
 func (err *Error) Error() string {
 	if err == nil || err.cause == nil {
 		return "Error is nil"
@@ -46,3 +183,34 @@ func (err *Error) Error() string {
 	return fmt.Sprintf("task %s, party %v, round %d: %s",
 		err.task, err.victim, err.round, err.cause.Error())
 }
+
+// ----- //
+
+func (err *classifiedError) Error() string { return err.err.Error() }
+
+func (err *classifiedError) Unwrap() []error { return []error{err.err, err.sentinel} }
+
+// ----- //
+
+// NewEvidence records a message as evidence. A message that was parsed from the wire is recorded in the bytes that it
+// was received as, so that an envelope keeps its signature and a point-to-point message stays encrypted; a message
+// that was given to Update already parsed is recorded as its wire bytes.
+func NewEvidence(msg Message) (Evidence, error) {
+	if impl, ok := msg.(*MessageImpl); ok && impl.received != nil {
+		return Evidence{From: impl.From, IsBroadcast: impl.MessageRouting.IsBroadcast, WireBytes: impl.received, Sealed: impl.sealed}, nil
+	}
+	bz, routing, err := msg.WireBytes()
+	if err != nil {
+		return Evidence{}, err
+	}
+	return Evidence{From: routing.From, IsBroadcast: routing.IsBroadcast, WireBytes: bz}, nil
+}
+
+// Message parses the evidence, so that its content can be checked independently.
+// Sealed evidence cannot be parsed here; its recipient opens it with Authenticator.Open or Parameters.ParseWireMessage.
+func (ev Evidence) Message() (ParsedMessage, error) {
+	if ev.Sealed {
+		return nil, errors.New("evidence: the message is sealed; it must be opened by its recipient")
+	}
+	return ParseWireMessage(ev.WireBytes, ev.From, ev.IsBroadcast)
+}
@@ -0,0 +1,115 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SafeMPC/tss-lib/crypto"
+	"github.com/SafeMPC/tss-lib/crypto/schnorr"
+	"github.com/SafeMPC/tss-lib/crypto/vss"
+	"github.com/SafeMPC/tss-lib/eddsa/keygen"
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+func TestClassify(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(2)
+	cause := errors.New("dln proof verification failed")
+	err := tss.NewError(tss.Classify(cause, tss.ErrInvalidProof), "keygen", 2, pIDs[0], pIDs[1])
+
+	assert.Equal(t, "task keygen, party {0,P[1]}, round 2, culprits [{1,P[2]}]: dln proof verification failed", err.Error())
+	assert.ErrorIs(t, err, tss.ErrInvalidProof)
+	assert.ErrorIs(t, err, cause)
+	assert.NotErrorIs(t, err, tss.ErrBadMessage)
+	assert.Equal(t, tss.CodeInvalidProof, err.Code())
+
+	var tErr *tss.Error
+	assert.ErrorAs(t, fmt.Errorf("wrapped: %w", err), &tErr)
+
+	// causes collected from several parties
+	multi := multierror.Append(nil, errors.New("other"), tss.Classify(errors.New("vss verify failed"), tss.ErrVSSFailure))
+	assert.Equal(t, tss.CodeVSSFailure, tss.NewError(multi, "keygen", 3, pIDs[0], pIDs[1]).Code())
+
+	assert.Equal(t, tss.CodeUnknown, tss.NewError(errors.New("other"), "keygen", 1, pIDs[0]).Code())
+}
+
+func TestAbortReport(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(2)
+	params := tss.NewParameters(tss.Edwards(), tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), 1)
+	P := keygen.NewLocalParty(params, make(chan tss.Message, len(pIDs)*len(pIDs)), nil)
+	if !assert.Nil(t, P.Start()) {
+		return
+	}
+	_, err := P.Update(keygen.NewKGRound1Message(pIDs[1], big.NewInt(1)))
+	assert.Nil(t, err)
+	_, err = P.Update(keygen.NewKGRound1Message(pIDs[1], big.NewInt(2)))
+	if !assert.NotNil(t, err) {
+		return
+	}
+	assert.ErrorIs(t, err, tss.ErrEquivocation)
+
+	bz, jErr := json.Marshal(err.Report())
+	assert.NoError(t, jErr)
+	report := new(tss.AbortReport)
+	assert.NoError(t, json.Unmarshal(bz, report))
+	assert.Equal(t, tss.CodeEquivocation, report.Code)
+	assert.Equal(t, keygen.TaskName, report.Task)
+	if assert.Len(t, report.Culprits, 1) {
+		assert.Equal(t, pIDs[1].Key, report.Culprits[0].Key)
+	}
+	// both conflicting messages are in the evidence and can be checked independently
+	if assert.Len(t, report.Evidence, 2) {
+		for i, want := range []int64{1, 2} {
+			msg, pErr := report.Evidence[i].Message()
+			if assert.NoError(t, pErr) {
+				assert.Equal(t, want, msg.Content().(*keygen.KGRound1Message).UnmarshalCommitment().Int64())
+			}
+		}
+	}
+}
+
+func TestEvidenceIsTheOffendingMessages(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(2)
+	ec := tss.Edwards()
+	params := tss.NewParameters(ec, tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), 1)
+	P := keygen.NewLocalParty(params, make(chan tss.Message, len(pIDs)*len(pIDs)), nil)
+	if !assert.Nil(t, P.Start()) {
+		return
+	}
+	// party 1 de-commits to something other than what it committed to
+	commitment := keygen.NewKGRound1Message(pIDs[1], big.NewInt(1))
+	share := keygen.NewKGRound2Message1(pIDs[0], pIDs[1], &vss.Share{Threshold: 1, ID: big.NewInt(1), Share: big.NewInt(1)})
+	proof := &schnorr.ZKProof{Alpha: crypto.ScalarBaseMult(ec, big.NewInt(1)), T: big.NewInt(1)}
+	deCommitment := keygen.NewKGRound2Message2(pIDs[1], []*big.Int{big.NewInt(1), big.NewInt(2)}, proof)
+	var err *tss.Error
+	for _, msg := range []tss.ParsedMessage{commitment, share, deCommitment} {
+		if _, err = P.Update(msg); err != nil {
+			break
+		}
+	}
+	if !assert.NotNil(t, err) {
+		return
+	}
+	assert.ErrorIs(t, err, tss.ErrCommitmentMismatch)
+
+	// only the commitment and the de-commitment are evidence, not the share that party 1 also sent
+	if assert.Len(t, err.Evidence(), 2) {
+		for i, want := range []tss.ParsedMessage{commitment, deCommitment} {
+			msg, pErr := err.Evidence()[i].Message()
+			if assert.NoError(t, pErr) {
+				assert.Equal(t, want.Type(), msg.Type())
+			}
+		}
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss
+
+import "crypto/elliptic"
+
+// Upper bounds on the byte lengths of the fields of protocol messages. A party that receives a larger integer rejects
+// the message in ValidateBasic, before it spends any time on the integer, so that a malicious party cannot make it
+// run modular exponentiations with huge operands. The bounds allow Paillier and NTilde moduli of up to MaxModulusBits
+// bits and curves with orders of up to 521 bits; tighter range checks against the actual moduli and curve order are
+// made when the fields are used.
+const (
+	MaxModulusBits = 4096
+	// MinModulusBits and DefaultModulusBits bound and default the sizes of the moduli that ECDSA keygen generates, see
+	// Parameters.SetModulusBits
+	MinModulusBits     = 2048
+	DefaultModulusBits = 2048
+
+	// hashes and hash commitments
+	MaxHashBytes = 32
+	// scalars, shares, EC point coordinates and the parts of hash de-commitments
+	MaxScalarBytes = 66
+	// Paillier and NTilde moduli and values modulo them
+	MaxModulusBytes = MaxModulusBits / 8
+	// Paillier ciphertexts, modulo N^2
+	MaxCiphertextBytes = 2 * MaxModulusBytes
+	// the parts of zero-knowledge proofs, the largest of which are modulo N^2 or masked by N*NTilde
+	MaxProofPartBytes = 2*MaxModulusBytes + 3*MaxScalarBytes
+
+	// DefaultMaxWireSize is the default limit on the size of the wire bytes that a party parses
+	DefaultMaxWireSize = 1 << 20
+)
+
+// CurveModulusBits is the least bit length of a Paillier modulus and NTilde for which the MtA range proofs over the
+// curve are sound. The proofs bound the values in powers of the curve order q, independently of the moduli; Bob's
+// proofs admit a product below q^6 plus a mask below q^7, which must not wrap around the Paillier modulus N, so
+// N > 2q^7.
+func CurveModulusBits(ec elliptic.Curve) int {
+	return 7*ec.Params().N.BitLen() + 2
+}
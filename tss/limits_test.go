@@ -0,0 +1,53 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SafeMPC/tss-lib/crypto/vss"
+	"github.com/SafeMPC/tss-lib/eddsa/keygen"
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+func TestMessageLimits(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(2)
+	params := tss.NewParameters(tss.Edwards(), tss.NewPeerContext(pIDs), pIDs[1], len(pIDs), 1)
+	assert.Equal(t, tss.DefaultMaxWireSize, params.MaxWireSize())
+
+	share := &vss.Share{Threshold: 1, ID: big.NewInt(1), Share: big.NewInt(42)}
+	bz := wireBytes(t, keygen.NewKGRound2Message1(pIDs[1], pIDs[0], share))
+	msg, err := params.ParseWireMessage(bz, pIDs[0], false)
+	if assert.NoError(t, err) {
+		assert.True(t, msg.ValidateBasic())
+	}
+
+	params.SetMaxWireSize(len(bz) - 1)
+	_, err = params.ParseWireMessage(bz, pIDs[0], false)
+	assert.True(t, errors.Is(err, tss.ErrBadMessage), "a message over the wire size limit must be rejected")
+
+	// the package-level parser, which has no parameters, enforces the default limit
+	huge := &vss.Share{Threshold: 1, ID: big.NewInt(1), Share: new(big.Int).Lsh(big.NewInt(1), 8*tss.DefaultMaxWireSize)}
+	bz = wireBytes(t, keygen.NewKGRound2Message1(pIDs[1], pIDs[0], huge))
+	_, err = tss.ParseWireMessage(bz, pIDs[0], false)
+	assert.True(t, errors.Is(err, tss.ErrBadMessage), "a message over the default wire size limit must be rejected")
+	params.SetMaxWireSize(2 * tss.DefaultMaxWireSize)
+	_, err = params.ParseWireMessage(bz, pIDs[0], false)
+	assert.NoError(t, err, "a party may raise the limit")
+
+	// a field longer than its limit fails ValidateBasic, before the receiver spends any time on it
+	params.SetMaxWireSize(tss.DefaultMaxWireSize)
+	share.Share = new(big.Int).Lsh(big.NewInt(1), 8*tss.MaxScalarBytes)
+	msg, err = params.ParseWireMessage(wireBytes(t, keygen.NewKGRound2Message1(pIDs[1], pIDs[0], share)), pIDs[0], false)
+	if assert.NoError(t, err) {
+		assert.False(t, msg.ValidateBasic())
+	}
+}
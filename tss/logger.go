@@ -0,0 +1,74 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/SafeMPC/tss-lib/common"
+)
+
+type (
+	// Logger is a structured logger for one party. The arguments after the message are alternating keys and values,
+	// as with log/slog. Parties enrich it with their party ID, task and round.
+	Logger interface {
+		Debug(msg string, args ...any)
+		Info(msg string, args ...any)
+		Warn(msg string, args ...any)
+		Error(msg string, args ...any)
+		With(args ...any) Logger
+	}
+
+	slogLogger struct {
+		logger *slog.Logger
+	}
+
+	// goLogLogger writes to the package-global common.Logger, which is used when no Logger is set
+	goLogLogger struct {
+		fields []any
+	}
+)
+
+// NewSlogLogger adapts a log/slog Logger to a Logger
+func NewSlogLogger(logger *slog.Logger) Logger {
+	return &slogLogger{logger: logger}
+}
+
+func (l *slogLogger) Debug(msg string, args ...any) { l.log(slog.LevelDebug, msg, args) }
+
+func (l *slogLogger) Info(msg string, args ...any) { l.log(slog.LevelInfo, msg, args) }
+
+func (l *slogLogger) Warn(msg string, args ...any) { l.log(slog.LevelWarn, msg, args) }
+
+func (l *slogLogger) Error(msg string, args ...any) { l.log(slog.LevelError, msg, args) }
+
+func (l *slogLogger) With(args ...any) Logger {
+	return &slogLogger{logger: l.logger.With(args...)}
+}
+
+func (l *slogLogger) log(level slog.Level, msg string, args []any) {
+	l.logger.Log(context.Background(), level, msg, args...)
+}
+
+// ----- //
+
+func (l *goLogLogger) Debug(msg string, args ...any) { common.Logger.Debugw(msg, l.args(args)...) }
+
+func (l *goLogLogger) Info(msg string, args ...any) { common.Logger.Infow(msg, l.args(args)...) }
+
+func (l *goLogLogger) Warn(msg string, args ...any) { common.Logger.Warnw(msg, l.args(args)...) }
+
+func (l *goLogLogger) Error(msg string, args ...any) { common.Logger.Errorw(msg, l.args(args)...) }
+
+func (l *goLogLogger) With(args ...any) Logger {
+	return &goLogLogger{fields: l.args(args)}
+}
+
+func (l *goLogLogger) args(args []any) []any {
+	return append(append(make([]any, 0, len(l.fields)+len(args)), l.fields...), args...)
+}
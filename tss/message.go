@@ -41,6 +41,8 @@ type (
 		Message
 		Content() MessageContent
 		ValidateBasic() bool
+		// The protocol version spoken by the party that sent the message
+		ProtocolVersion() uint32
 	}
 
 	// MessageContent represents a ProtoBuf message with validation logic
@@ -49,6 +51,12 @@ type (
 		ValidateBasic() bool
 	}
 
+	// RoundContent is implemented by MessageContent that belongs to a single protocol round
+	RoundContent interface {
+		MessageContent
+		RoundNumber() int
+	}
+
 	// MessageRouting holds the full routing information for the message, consumed by the transport
 	MessageRouting struct {
 		// which participant this message came from
@@ -68,6 +76,11 @@ type (
 		MessageRouting
 		content MessageContent
 		wire    *MessageWrapper
+		version uint32
+		// the bytes that a message parsed from the wire was received as, and whether they were in an envelope or
+		// encrypted; these are kept as evidence instead of the content, which was authenticated only in that form
+		received []byte
+		sealed   bool
 	}
 )
 
@@ -107,6 +120,7 @@ func NewMessage(meta MessageRouting, content MessageContent, wire *MessageWrappe
 		MessageRouting: meta,
 		content:        content,
 		wire:           wire,
+		version:        ProtocolVersion,
 	}
 }
 
@@ -141,7 +155,18 @@ func (mm *MessageImpl) WireBytes() ([]byte, *MessageRouting, error) {
 	if err != nil {
 		return nil, nil, err
 	}
-	return bz, &mm.MessageRouting, nil
+	return appendProtocolVersion(bz, mm.version), &mm.MessageRouting, nil
+}
+
+func (mm *MessageImpl) ProtocolVersion() uint32 {
+	return mm.version
+}
+
+// withProtocolVersion returns a copy of the message that is sent with another protocol version
+func (mm *MessageImpl) withProtocolVersion(version uint32) *MessageImpl {
+	cpy := *mm
+	cpy.version = version
+	return &cpy
 }
 
 func (mm *MessageImpl) WireMsg() *MessageWrapper {
@@ -0,0 +1,40 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss
+
+import (
+	"time"
+)
+
+type (
+	// Observer receives events from a party, e.g. to export metrics.
+	// Its methods are called synchronously from the party's goroutines, some concurrently, and must return quickly.
+	// Embed NoopObserver to implement only some of them.
+	Observer interface {
+		RoundStarted(party *PartyID, task string, round int)
+		RoundFinished(party *PartyID, task string, round int, elapsed time.Duration)
+		// MessageSent is reported by SealWireMessage, which tss.Run calls for every outbound message
+		MessageSent(party *PartyID, msgType string, size int)
+		MessageReceived(party *PartyID, msgType string, from *PartyID, size int)
+		ProofVerified(party *PartyID, task string, round int, proof string, elapsed time.Duration, ok bool)
+	}
+
+	// NoopObserver ignores every event
+	NoopObserver struct{}
+)
+
+var _ Observer = NoopObserver{}
+
+func (NoopObserver) RoundStarted(*PartyID, string, int) {}
+
+func (NoopObserver) RoundFinished(*PartyID, string, int, time.Duration) {}
+
+func (NoopObserver) MessageSent(*PartyID, string, int) {}
+
+func (NoopObserver) MessageReceived(*PartyID, string, *PartyID, int) {}
+
+func (NoopObserver) ProofVerified(*PartyID, string, int, string, time.Duration, bool) {}
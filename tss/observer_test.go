@@ -0,0 +1,109 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SafeMPC/tss-lib/eddsa/keygen"
+	"github.com/SafeMPC/tss-lib/test"
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+type recordingObserver struct {
+	tss.NoopObserver
+	mtx                   sync.Mutex
+	started, finished     []int
+	sent, received, proof int
+}
+
+func (o *recordingObserver) RoundStarted(_ *tss.PartyID, _ string, round int) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	o.started = append(o.started, round)
+}
+
+func (o *recordingObserver) RoundFinished(_ *tss.PartyID, _ string, round int, _ time.Duration) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	o.finished = append(o.finished, round)
+}
+
+func (o *recordingObserver) MessageSent(_ *tss.PartyID, _ string, size int) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	if size > 0 {
+		o.sent++
+	}
+}
+
+func (o *recordingObserver) MessageReceived(_ *tss.PartyID, _ string, from *tss.PartyID, size int) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	if from != nil && size > 0 {
+		o.received++
+	}
+}
+
+func (o *recordingObserver) ProofVerified(_ *tss.PartyID, task string, _ int, proof string, _ time.Duration, ok bool) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	if task == keygen.TaskName && proof == "schnorr" && ok {
+		o.proof++
+	}
+}
+
+func TestLoggerAndObserver(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(test.TestParticipants)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	observer := new(recordingObserver)
+	var logs bytes.Buffer
+	logger := tss.NewSlogLogger(slog.New(slog.NewJSONHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	results := runKeygen(ctx, t, pIDs, func(i int, params *tss.Parameters) {
+		if i == 0 {
+			params.SetLogger(logger)
+			params.SetObserver(observer)
+		}
+	})
+	for _, res := range results {
+		assert.Nil(t, res.err)
+	}
+
+	observer.mtx.Lock()
+	defer observer.mtx.Unlock()
+	assert.Equal(t, []int{1, 2, 3}, observer.started)
+	assert.Equal(t, []int{1, 2, 3}, observer.finished)
+	// round 1 broadcasts; round 2 sends a share to each peer and broadcasts, as does every peer
+	assert.Equal(t, 1+len(pIDs), observer.sent)
+	assert.Equal(t, 3*(len(pIDs)-1), observer.received)
+	assert.Equal(t, len(pIDs)-1, observer.proof, "the schnorr proof of every peer is verified")
+
+	started := 0
+	for _, line := range bytes.Split(bytes.TrimSpace(logs.Bytes()), []byte("\n")) {
+		record := make(map[string]any)
+		if !assert.NoError(t, json.Unmarshal(line, &record)) {
+			continue
+		}
+		assert.Equal(t, pIDs[0].String(), record["party"])
+		if record["msg"] == "round started" {
+			assert.Equal(t, keygen.TaskName, record["task"])
+			assert.EqualValues(t, started+1, record["round"])
+			started++
+		}
+	}
+	assert.Equal(t, 3, started)
+}
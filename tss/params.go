@@ -7,13 +7,21 @@
 package tss
 
 import (
+	"bytes"
 	"crypto/elliptic"
 	"crypto/rand"
+	"errors"
+	"fmt"
 	"io"
+	"math/big"
 	"runtime"
 	"time"
+
+	"github.com/SafeMPC/tss-lib/common"
 )
 
+var ssidNonceDomain = []byte("tss-lib session id")
+
 type (
 	Parameters struct {
 		ec                  elliptic.Curve
@@ -23,13 +31,37 @@ type (
 		threshold           int
 		concurrency         int
 		safePrimeGenTimeout time.Duration
+		// shared workers for the heavy cryptographic work
+		workerPool *WorkerPool
+		priority   Priority
+		// the points at which the parties' shares are evaluated, if not their keys
+		shareIDs []*big.Int
 		// proof session info
-		nonce int
+		nonce     int
+		sessionID []byte
 		// for keygen
-		noProofMod bool
-		noProofFac bool
+		noProofMod  bool
+		noProofFac  bool
+		modulusBits int
+		complaints  bool
+		// run an echo sub-round after each round with broadcasts
+		echoBroadcast bool
 		// random sources
 		partialKeyRand, rand io.Reader
+		// the protocol version spoken and the versions accepted from peers
+		protocolVersion   uint32
+		compatibilityMode bool
+		// message envelopes
+		maxWireSize   int
+		authenticator *Authenticator
+		encryptionKey []byte
+		// key used to encrypt snapshots of in-progress parties
+		snapshotKey []byte
+		// logging and instrumentation
+		logger   Logger
+		observer Observer
+		// records a transcript of the party
+		recorder *Recorder
 	}
 
 	ReSharingParameters struct {
@@ -37,6 +69,7 @@ type (
 		newParties    *PeerContext
 		newPartyCount int
 		newThreshold  int
+		newShareIDs   []*big.Int
 	}
 )
 
@@ -54,6 +87,8 @@ func NewParameters(ec elliptic.Curve, ctx *PeerContext, partyID *PartyID, partyC
 		threshold:           threshold,
 		concurrency:         runtime.GOMAXPROCS(0),
 		safePrimeGenTimeout: defaultSafePrimeGenTimeout,
+		protocolVersion:     ProtocolVersion,
+		maxWireSize:         DefaultMaxWireSize,
 		partialKeyRand:      rand.Reader,
 		rand:                rand.Reader,
 	}
@@ -96,6 +131,44 @@ func (params *Parameters) SetSafePrimeGenTimeout(timeout time.Duration) {
 	params.safePrimeGenTimeout = timeout
 }
 
+// WorkerPool is the pool that runs the party's heavy cryptographic work, if one was set
+func (params *Parameters) WorkerPool() *WorkerPool {
+	return params.workerPool
+}
+
+// SetWorkerPool runs the party's heavy cryptographic work on a pool that may be shared with other parties, instead of
+// on goroutines of its own
+func (params *Parameters) SetWorkerPool(pool *WorkerPool) {
+	params.workerPool = pool
+}
+
+// Priority is the priority of the party's work on its worker pool; it defaults to PriorityNormal
+func (params *Parameters) Priority() Priority {
+	return params.priority
+}
+
+// SetPriority sets the priority of the party's work on its worker pool, e.g. PriorityHigh for latency-sensitive signing.
+// Pre-parameters that the party generates itself are always generated at PriorityLow.
+func (params *Parameters) SetPriority(priority Priority) {
+	params.priority = priority
+}
+
+// Executor runs the party's heavy cryptographic work at the given priority, or the party's Priority if none is given:
+// on its worker pool if it has one, and otherwise on goroutines of its own
+func (params *Parameters) Executor(priority ...Priority) common.Executor {
+	// a recorded party does its work in a fixed order so that it can be replayed; pre-parameters are generated apart
+	if params.recorder != nil && (len(priority) == 0 || priority[0] != PriorityLow) {
+		return inlineExecutor{}
+	}
+	if params.workerPool == nil {
+		return common.GoExecutor
+	}
+	if len(priority) == 0 {
+		return params.workerPool.Executor(params, params.priority)
+	}
+	return params.workerPool.Executor(params, priority[0])
+}
+
 func (params *Parameters) NoProofMod() bool {
 	return params.noProofMod
 }
@@ -112,11 +185,88 @@ func (params *Parameters) SetNoProofFac() {
 	params.noProofFac = true
 }
 
+// ModulusBits is the bit length of the Paillier modulus and NTilde that ECDSA keygen and re-sharing generate and
+// require of every party; it defaults to DefaultModulusBits
+func (params *Parameters) ModulusBits() int {
+	if params.modulusBits == 0 {
+		return DefaultModulusBits
+	}
+	return params.modulusBits
+}
+
+// SetModulusBits sets the bit length of the Paillier modulus and NTilde, an even number between MinModulusBits and
+// MaxModulusBits. All parties must set the same size; keygen commits to it in the SSID and records it in the save data.
+func (params *Parameters) SetModulusBits(bits int) {
+	params.modulusBits = bits
+}
+
+func (params *Parameters) Complaints() bool {
+	return params.complaints
+}
+
+// SetComplaints enables the complaint round of ECDSA keygen. A party that receives a share that fails verification
+// accuses its dealer instead of aborting, and the dealer reveals the disputed share to all parties, who agree on
+// whether it is valid. All parties must enable it; keygen commits to it in the SSID. The complaints and the revealed
+// shares are broadcast, so the verdict is only agreed on with a reliable broadcast: Validate requires SetEchoBroadcast.
+func (params *Parameters) SetComplaints() {
+	params.complaints = true
+}
+
+func (params *Parameters) EchoBroadcast() bool {
+	return params.echoBroadcast
+}
+
+// ShareIDs returns the share ID of each party, in the order of Parties().IDs(). A share ID is the point at which the
+// Shamir share of a party is evaluated; it is the party's key unless the share IDs were set with SetShareIDs.
+func (params *Parameters) ShareIDs() []*big.Int {
+	if params.shareIDs != nil {
+		return params.shareIDs
+	}
+	return params.parties.IDs().Keys()
+}
+
+// SetShareIDs sets the share ID of each party, in the order of Parties().IDs(), so that the shares are independent of
+// the parties' keys, e.g. SequentialShareIDs. Keygen records them in the save data; signing and re-sharing must be given
+// the share IDs that the keys of their parties were dealt with.
+func (params *Parameters) SetShareIDs(shareIDs []*big.Int) {
+	params.shareIDs = shareIDs
+}
+
+// CustomShareIDs reports whether the share IDs differ from the parties' keys. The SSIDs of keygen and re-sharing bind
+// them only then, so that the SSIDs of parties without share IDs remain those of older releases.
+func (params *Parameters) CustomShareIDs() bool {
+	return !common.EqualInts(params.ShareIDs(), params.parties.IDs().Keys())
+}
+
+// SequentialShareIDs returns the share IDs 1..n
+func SequentialShareIDs(n int) []*big.Int {
+	shareIDs := make([]*big.Int, n)
+	for i := range shareIDs {
+		shareIDs[i] = big.NewInt(int64(i + 1))
+	}
+	return shareIDs
+}
+
+// SetEchoBroadcast enables an echo sub-round after every round with broadcasts, in which the parties compare digests
+// of the broadcasts that they received and abort with ErrEquivocation if they disagree. With an Authenticator the
+// echoes relay the signed envelopes of the broadcasts, so the abort blames the sender if it signed two different
+// broadcasts and the echoer otherwise. Without one an equivocating sender cannot be told apart from a lying echoer, so
+// the abort blames both. Not supported by re-sharing.
+func (params *Parameters) SetEchoBroadcast() {
+	params.echoBroadcast = true
+}
+
 func (params *Parameters) PartialKeyRand() io.Reader {
+	if params.recorder != nil {
+		return recordingReader{src: params.partialKeyRand, rec: params.recorder, partialKey: true}
+	}
 	return params.partialKeyRand
 }
 
 func (params *Parameters) Rand() io.Reader {
+	if params.recorder != nil {
+		return recordingReader{src: params.rand, rec: params.recorder}
+	}
 	return params.rand
 }
 
@@ -128,6 +278,276 @@ func (params *Parameters) SetRand(rand io.Reader) {
 	params.rand = rand
 }
 
+// ProtocolVersion is the version of the protocol that the party speaks; it defaults to the ProtocolVersion of this release
+func (params *Parameters) ProtocolVersion() uint32 {
+	return params.protocolVersion
+}
+
+// SetProtocolVersion makes the party speak an older version of the protocol. SealWireMessage sends the party's
+// messages with this version, and ParseWireMessage rejects peers that speak another version unless the party is in
+// compatibility mode.
+func (params *Parameters) SetProtocolVersion(version uint32) {
+	params.protocolVersion = version
+}
+
+func (params *Parameters) CompatibilityMode() bool {
+	return params.compatibilityMode
+}
+
+// SetCompatibilityMode makes the party accept peers that speak older versions of the protocol than its own, such as
+// parties that have not been upgraded yet during a rolling upgrade. Peers that speak newer versions are still rejected.
+func (params *Parameters) SetCompatibilityMode() {
+	params.compatibilityMode = true
+}
+
+// MaxWireSize is the largest message, in wire bytes, that ParseWireMessage accepts
+func (params *Parameters) MaxWireSize() int {
+	return params.maxWireSize
+}
+
+// SetMaxWireSize sets the largest message, in wire bytes, that ParseWireMessage accepts; the default is DefaultMaxWireSize
+func (params *Parameters) SetMaxWireSize(size int) {
+	params.maxWireSize = size
+}
+
+// Authenticator returns the Authenticator used to seal and open this party's messages, or nil if envelopes are not used
+func (params *Parameters) Authenticator() *Authenticator {
+	return params.authenticator
+}
+
+// SetAuthenticator enables authenticated message envelopes.
+// Outbound messages must then be sealed with Authenticator().Seal (tss.Run does this) and UpdateFromBytes only accepts envelopes.
+func (params *Parameters) SetAuthenticator(authenticator *Authenticator) {
+	params.authenticator = authenticator
+}
+
+func (params *Parameters) EncryptionKey() []byte {
+	return params.encryptionKey
+}
+
+// SetEncryptionKey enables encryption of point-to-point messages with this party's X25519 private key.
+// Every party's PartyID must then carry its public EncryptionKey.
+func (params *Parameters) SetEncryptionKey(privateKey []byte) {
+	params.encryptionKey = privateKey
+}
+
+func (params *Parameters) SessionID() []byte {
+	return params.sessionID
+}
+
+// SetSessionID binds the party to one protocol session. The ID is hashed into the SSID of the protocol, and so into
+// every proof challenge, and into the encryption of point-to-point messages; an Authenticator must be created with
+// the same ID. Each concurrent session must use a distinct ID, which all of its parties agree on.
+func (params *Parameters) SetSessionID(sessionID []byte) {
+	params.sessionID = sessionID
+	if params.recorder != nil {
+		params.recorder.setSessionID(sessionID)
+	}
+}
+
+// SSIDNonce returns the nonce that the protocols hash into their SSID. It is zero when no session ID is set, which
+// keeps transcripts compatible with parties that do not set one.
+func (params *Parameters) SSIDNonce() *big.Int {
+	if len(params.sessionID) == 0 {
+		return new(big.Int)
+	}
+	return new(big.Int).SetBytes(common.SHA512_256(ssidNonceDomain, params.sessionID))
+}
+
+func (params *Parameters) SnapshotKey() []byte {
+	return params.snapshotKey
+}
+
+// SetSnapshotKey sets the 32-byte key under which snapshots of this party are encrypted and restored
+func (params *Parameters) SetSnapshotKey(key []byte) {
+	params.snapshotKey = key
+}
+
+// Logger returns the party's Logger, enriched with its party ID. The global common.Logger is used if none is set.
+func (params *Parameters) Logger() Logger {
+	logger := params.logger
+	if logger == nil {
+		logger = &goLogLogger{}
+	}
+	return logger.With("party", params.partyID.String())
+}
+
+func (params *Parameters) SetLogger(logger Logger) {
+	params.logger = logger
+}
+
+// Observer returns the party's Observer, or nil if none is set
+func (params *Parameters) Observer() Observer {
+	return params.observer
+}
+
+func (params *Parameters) SetObserver(observer Observer) {
+	params.observer = observer
+}
+
+// Recorder returns the party's Recorder, or nil if none is set
+func (params *Parameters) Recorder() *Recorder {
+	return params.recorder
+}
+
+// SetRecorder records a Transcript of the party on rec, which can be replayed with NewReplay
+func (params *Parameters) SetRecorder(rec *Recorder) {
+	if rec != nil && params.sessionID != nil {
+		rec.setSessionID(params.sessionID)
+	}
+	params.recorder = rec
+}
+
+// ObserveProof reports to the Observer, if one is set, that a verification of the named proof started at `start`
+// and completed with the result `ok`
+func (params *Parameters) ObserveProof(task string, round int, proof string, start time.Time, ok bool) {
+	if params.observer != nil {
+		params.observer.ProofVerified(params.partyID, task, round, proof, time.Since(start), ok)
+	}
+}
+
+// SealWireMessage prepares an outbound message for the wire: a point-to-point message is encrypted to its recipient if
+// an encryption key is set, and the message is then sealed in an envelope if an Authenticator is set.
+func (params *Parameters) SealWireMessage(msg Message) (Message, error) {
+	if err := params.checkSession(); err != nil {
+		return nil, err
+	}
+	var err error
+	msgType := msg.Type()
+	if mm, ok := msg.(*MessageImpl); ok && mm.version != params.protocolVersion {
+		msg = mm.withProtocolVersion(params.protocolVersion)
+	}
+	if params.recorder != nil {
+		if err = params.recorder.recordOutbound(msg); err != nil {
+			return nil, err
+		}
+	}
+	if params.encryptionKey != nil {
+		if msg, err = encryptMessage(params.encryptionKey, params.sessionID, msg, params.rand); err != nil {
+			return nil, err
+		}
+	}
+	if params.authenticator != nil {
+		if msg, err = params.authenticator.Seal(msg); err != nil {
+			return nil, err
+		}
+	}
+	if params.observer != nil {
+		wireBytes, _, err := msg.WireBytes()
+		if err != nil {
+			return nil, err
+		}
+		params.observer.MessageSent(params.partyID, msgType, len(wireBytes))
+	}
+	return msg, nil
+}
+
+// ParseWireMessage parses the wire bytes of a message received by this party, reversing SealWireMessage
+func (params *Parameters) ParseWireMessage(wireBytes []byte, from *PartyID, isBroadcast bool) (ParsedMessage, error) {
+	if params.maxWireSize < len(wireBytes) {
+		err := Classify(fmt.Errorf("the message of %d bytes exceeds the limit of %d bytes", len(wireBytes), params.maxWireSize), ErrBadMessage)
+		if params.authenticator != nil {
+			return nil, unauthenticatedError{err}
+		}
+		return nil, err
+	}
+	if err := params.checkSession(); err != nil {
+		return nil, unauthenticatedError{err}
+	}
+	payload, encrypted := wireBytes, false
+	var env *Envelope
+	if params.authenticator != nil {
+		var err error
+		if env, err = params.authenticator.open(wireBytes, from, params.partyID, isBroadcast); err != nil {
+			return nil, unauthenticatedError{err}
+		}
+		payload, isBroadcast = env.GetPayload(), env.GetIsBroadcast()
+	}
+	if params.encryptionKey != nil {
+		plaintext, ok, err := decryptPayload(params.encryptionKey, params.sessionID, payload, from, params.partyID)
+		if err != nil {
+			return nil, err
+		}
+		encrypted = ok
+		if !isBroadcast && !encrypted {
+			return nil, errors.New("received a point-to-point message that was not encrypted")
+		}
+		payload = plaintext
+	}
+	msg, err := parseWireMessage(payload, from, isBroadcast)
+	if err != nil {
+		return nil, err
+	}
+	if err = params.checkProtocolVersion(msg.ProtocolVersion()); err != nil {
+		return nil, Classify(err, ErrVersionMismatch)
+	}
+	if env != nil {
+		if err = params.authenticator.accept(env, msg, from); err != nil {
+			return nil, err
+		}
+	}
+	// the message is kept as evidence in the form that it was authenticated in, never as a decrypted payload
+	impl := msg.(*MessageImpl)
+	impl.received, impl.sealed = wireBytes, env != nil || encrypted
+	if params.observer != nil {
+		params.observer.MessageReceived(params.partyID, msg.Type(), from, len(wireBytes))
+	}
+	return msg, nil
+}
+
+// checkProtocolVersion ensures that the party can interoperate with a peer that speaks the given version
+func (params *Parameters) checkProtocolVersion(version uint32) error {
+	switch {
+	case params.protocolVersion <= ProtocolV1:
+		// parties that predate protocol versions do not check them
+		return nil
+	case params.protocolVersion < version:
+		return fmt.Errorf("the peer speaks protocol version %d, which is newer than the version %d of this party", version, params.protocolVersion)
+	case version < params.protocolVersion && !params.compatibilityMode:
+		return fmt.Errorf("the peer speaks protocol version %d, which is older than the version %d of this party; "+
+			"enable compatibility mode to accept it", version, params.protocolVersion)
+	}
+	return nil
+}
+
+// checkSession ensures that envelopes are sealed and opened for the party's session
+func (params *Parameters) checkSession() error {
+	if params.authenticator != nil && len(params.sessionID) > 0 && !bytes.Equal(params.authenticator.SessionID(), params.sessionID) {
+		return errors.New("the authenticator belongs to a different session than the parameters")
+	}
+	return nil
+}
+
+// Validate checks the parties, the party count and the threshold, and that this party is one of the parties
+func (params *Parameters) Validate() error {
+	if params.partyID == nil || !params.partyID.ValidateBasic() {
+		return fmt.Errorf("this party has an invalid PartyID: %v", params.partyID)
+	}
+	if err := validateCommittee(params.ec, params.parties, params.partyCount, params.threshold); err != nil {
+		return err
+	}
+	if !isMember(params.parties, params.partyID) {
+		return fmt.Errorf("this party %s is not in the peer context", params.partyID)
+	}
+	if err := validateModulusBits(params.modulusBits); err != nil {
+		return err
+	}
+	// without a reliable broadcast a complaint or a revealed share can be delivered to some parties only, which then
+	// reach other verdicts than the rest
+	if params.complaints && !params.echoBroadcast {
+		return errors.New("complaints need the echo broadcast to be enabled")
+	}
+	return validateShareIDs(params.ec, params.parties, params.shareIDs)
+}
+
+func validateModulusBits(bits int) error {
+	if bits != 0 && (bits < MinModulusBits || MaxModulusBits < bits || bits%2 != 0) {
+		return fmt.Errorf("the modulus size must be an even number of bits between %d and %d, got %d",
+			MinModulusBits, MaxModulusBits, bits)
+	}
+	return nil
+}
+
 // ----- //
 
 // Exported, used in `tss` client
@@ -161,6 +581,25 @@ func (rgParams *ReSharingParameters) NewThreshold() int {
 	return rgParams.newThreshold
 }
 
+// NewShareIDs returns the share ID of each party of the new committee, in the order of NewParties().IDs(); the share
+// IDs of the old committee are those of ShareIDs
+func (rgParams *ReSharingParameters) NewShareIDs() []*big.Int {
+	if rgParams.newShareIDs != nil {
+		return rgParams.newShareIDs
+	}
+	return rgParams.newParties.IDs().Keys()
+}
+
+// CustomNewShareIDs reports whether the share IDs of the new committee differ from the keys of its parties
+func (rgParams *ReSharingParameters) CustomNewShareIDs() bool {
+	return !common.EqualInts(rgParams.NewShareIDs(), rgParams.newParties.IDs().Keys())
+}
+
+// SetNewShareIDs sets the share ID of each party of the new committee, in the order of NewParties().IDs()
+func (rgParams *ReSharingParameters) SetNewShareIDs(shareIDs []*big.Int) {
+	rgParams.newShareIDs = shareIDs
+}
+
 func (rgParams *ReSharingParameters) OldAndNewParties() []*PartyID {
 	return append(rgParams.OldParties().IDs(), rgParams.NewParties().IDs()...)
 }
@@ -188,3 +627,35 @@ func (rgParams *ReSharingParameters) IsNewCommittee() bool {
 	}
 	return false
 }
+
+// Validate checks both committees, and that this party is in the old committee, the new committee or both
+func (rgParams *ReSharingParameters) Validate() error {
+	if rgParams.partyID == nil || !rgParams.partyID.ValidateBasic() {
+		return fmt.Errorf("this party has an invalid PartyID: %v", rgParams.partyID)
+	}
+	// the old committee may reshare with any quorum of its parties
+	oldParties := rgParams.OldParties()
+	if err := oldParties.Validate(rgParams.ec); err != nil {
+		return fmt.Errorf("old committee: %w", err)
+	}
+	if rgParams.Threshold() < 1 || len(oldParties.IDs()) <= rgParams.Threshold() || rgParams.OldPartyCount() < len(oldParties.IDs()) {
+		return fmt.Errorf("old committee: %d parties cannot reshare a key of %d parties with the threshold %d",
+			len(oldParties.IDs()), rgParams.OldPartyCount(), rgParams.Threshold())
+	}
+	if err := validateCommittee(rgParams.ec, rgParams.NewParties(), rgParams.NewPartyCount(), rgParams.NewThreshold()); err != nil {
+		return fmt.Errorf("new committee: %w", err)
+	}
+	if !isMember(oldParties, rgParams.partyID) && !isMember(rgParams.NewParties(), rgParams.partyID) {
+		return fmt.Errorf("this party %s is in neither the old nor the new committee", rgParams.partyID)
+	}
+	if err := validateModulusBits(rgParams.modulusBits); err != nil {
+		return err
+	}
+	if err := validateShareIDs(rgParams.ec, oldParties, rgParams.shareIDs); err != nil {
+		return fmt.Errorf("old committee: %w", err)
+	}
+	if err := validateShareIDs(rgParams.ec, rgParams.NewParties(), rgParams.newShareIDs); err != nil {
+		return fmt.Errorf("new committee: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SafeMPC/tss-lib/common"
+	"github.com/SafeMPC/tss-lib/eddsa/keygen"
+	"github.com/SafeMPC/tss-lib/eddsa/signing"
+	"github.com/SafeMPC/tss-lib/test"
+	"github.com/SafeMPC/tss-lib/tss"
+	"github.com/SafeMPC/tss-lib/tss/transport"
+)
+
+// TestShareIDs deals a key at the share IDs 1..n and signs with it after the parties have new identities
+func TestShareIDs(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(test.TestParticipants)
+	shareIDs := tss.SequentialShareIDs(len(pIDs))
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	results := runKeygen(ctx, t, pIDs, func(_ int, params *tss.Parameters) {
+		params.SetShareIDs(shareIDs)
+	})
+	keys := make([]keygen.LocalPartySaveData, len(pIDs))
+	for _, res := range results {
+		if !assert.Nil(t, res.err) {
+			return
+		}
+		assert.Equal(t, shareIDs, res.save.Ks)
+		index, err := res.save.OriginalIndex()
+		if !assert.NoError(t, err) {
+			return
+		}
+		keys[index] = *res.save
+	}
+	assert.Equal(t, big.NewInt(1), keys[0].ShareID)
+
+	// the parties sign with new keys; the share IDs of the signers are given in the order of their new IDs
+	signers := tss.GenerateTestPartyIDs(test.TestThreshold + 1)
+	signCtx := tss.NewPeerContext(signers)
+	hub := transport.NewHub()
+	defer hub.Close()
+	msg := big.NewInt(42)
+	errCh := make(chan *tss.Error, len(signers))
+	sigCh := make(chan *common.SignatureData, len(signers))
+	for i, pID := range signers {
+		params := tss.NewParameters(tss.Edwards(), signCtx, pID, len(signers), test.TestThreshold)
+		params.SetShareIDs(shareIDs[:len(signers)])
+		conn, err := hub.Connect(pID)
+		if !assert.NoError(t, err) {
+			return
+		}
+		outCh := make(chan tss.Message, len(signers))
+		endCh := make(chan *common.SignatureData, 1)
+		P := signing.NewLocalParty(msg, params, keys[i], outCh, endCh)
+		go func() {
+			sig, err := tss.Run(ctx, P, conn, outCh, endCh)
+			errCh <- err
+			sigCh <- sig
+		}()
+	}
+	pk := edwards.PublicKey{Curve: tss.Edwards(), X: keys[0].EDDSAPub.X(), Y: keys[0].EDDSAPub.Y()}
+	for range signers {
+		if !assert.Nil(t, <-errCh) {
+			return
+		}
+		sig, err := edwards.ParseSignature((<-sigCh).GetSignature())
+		if assert.NoError(t, err) {
+			assert.True(t, edwards.Verify(&pk, msg.Bytes(), sig.R, sig.S), "eddsa verify must pass")
+		}
+	}
+}
+
+func TestCustomShareIDs(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(test.TestParticipants)
+	params := tss.NewParameters(tss.Edwards(), tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), test.TestThreshold)
+	assert.False(t, params.CustomShareIDs())
+	params.SetShareIDs(pIDs.Keys())
+	assert.False(t, params.CustomShareIDs(), "share IDs that are the parties' keys change no SSID")
+	params.SetShareIDs(tss.SequentialShareIDs(len(pIDs)))
+	assert.True(t, params.CustomShareIDs())
+
+	newPIDs := tss.GenerateTestPartyIDs(test.TestParticipants)
+	rgParams := tss.NewReSharingParameters(tss.Edwards(), tss.NewPeerContext(pIDs), tss.NewPeerContext(newPIDs), pIDs[0], len(pIDs), test.TestThreshold, len(newPIDs), test.TestThreshold)
+	assert.False(t, rgParams.CustomNewShareIDs())
+	rgParams.SetNewShareIDs(tss.SequentialShareIDs(len(newPIDs)))
+	assert.True(t, rgParams.CustomNewShareIDs())
+}
+
+func TestComplaintsNeedEchoBroadcast(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(test.TestParticipants)
+	params := tss.NewParameters(tss.S256(), tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), test.TestThreshold)
+	params.SetComplaints()
+	assert.Error(t, params.Validate(), "complaints without a reliable broadcast must be rejected")
+	params.SetEchoBroadcast()
+	assert.NoError(t, params.Validate())
+}
+
+func TestModulusBits(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(3)
+	params := tss.NewParameters(tss.S256(), tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), 1)
+	assert.Equal(t, tss.DefaultModulusBits, params.ModulusBits())
+	assert.LessOrEqual(t, tss.CurveModulusBits(tss.S256()), tss.DefaultModulusBits)
+	for bits, valid := range map[int]bool{2048: true, 3072: true, 4096: true, 1024: false, 3071: false, 8192: false} {
+		params.SetModulusBits(bits)
+		assert.Equal(t, valid, params.Validate() == nil, bits)
+	}
+}
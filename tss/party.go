@@ -10,8 +10,9 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
-	"github.com/SafeMPC/tss-lib/common"
+	"google.golang.org/protobuf/proto"
 )
 
 type Party interface {
@@ -27,6 +28,8 @@ type Party interface {
 	StoreMessage(msg ParsedMessage) (bool, *Error)
 	FirstRound() Round
 	WrapError(err error, culprits ...*PartyID) *Error
+	// Abort stops the party and overwrites the secrets that it holds; the party rejects every message afterwards
+	Abort(reason error) *Error
 	PartyID() *PartyID
 	String() string
 
@@ -36,12 +39,37 @@ type Party interface {
 	advance()
 	lock()
 	unlock()
+	outbound() chan<- Message
+	echoes() *echoState
+	record(msg ParsedMessage)
+	evidenceFrom(culprits []*PartyID) []Evidence
+	runner() *Actor
+	setRunner(a *Actor)
+	taskName() string
+	setTaskName(task string)
+	roundStartedAt() time.Time
+	aborted() *Error
+	setAborted(err *Error)
 }
 
 type BaseParty struct {
 	mtx        sync.Mutex
 	rnd        Round
+	rndStart   time.Time
 	FirstRound Round
+	out        chan<- Message
+	echo       echoState
+	// messages received from each party by key, kept as evidence in case it is blamed
+	received map[string][]ParsedMessage
+	abortErr *Error
+	// the Actor that runs the party, if any, and the task that the party was started as
+	actor *Actor
+	task  string
+}
+
+// NewBaseParty creates a BaseParty that can send its own messages, such as echo broadcasts, on `out`
+func NewBaseParty(out chan<- Message) *BaseParty {
+	return &BaseParty{out: out}
 }
 
 func (p *BaseParty) Running() bool {
@@ -54,6 +82,9 @@ func (p *BaseParty) WaitingFor() []*PartyID {
 	if p.rnd == nil {
 		return []*PartyID{}
 	}
+	if missing := p.echo.waitingFor(p.rnd); len(missing) > 0 {
+		return missing
+	}
 	return p.rnd.WaitingFor()
 }
 
@@ -67,13 +98,13 @@ func (p *BaseParty) WrapError(err error, culprits ...*PartyID) *Error {
 // an implementation of ValidateMessage that is shared across the different types of parties (keygen, signing, dynamic groups)
 func (p *BaseParty) ValidateMessage(msg ParsedMessage) (bool, *Error) {
 	if msg == nil || msg.Content() == nil {
-		return false, p.WrapError(fmt.Errorf("received nil msg: %s", msg))
+		return false, p.WrapError(Classify(fmt.Errorf("received nil msg: %s", msg), ErrBadMessage))
 	}
 	if msg.GetFrom() == nil || !msg.GetFrom().ValidateBasic() {
-		return false, p.WrapError(fmt.Errorf("received msg with an invalid sender: %s", msg))
+		return false, p.WrapError(Classify(fmt.Errorf("received msg with an invalid sender: %s", msg), ErrBadMessage))
 	}
 	if !msg.ValidateBasic() {
-		return false, p.WrapError(fmt.Errorf("message failed ValidateBasic: %s", msg), msg.GetFrom())
+		return false, p.WrapError(Classify(fmt.Errorf("message failed ValidateBasic: %s", msg), ErrBadMessage), msg.GetFrom()).WithMessages(msg)
 	}
 	return true, nil
 }
@@ -94,6 +125,7 @@ func (p *BaseParty) setRound(round Round) *Error {
 		return p.WrapError(errors.New("a round is already set on this party"))
 	}
 	p.rnd = round
+	p.rndStart = time.Now()
 	return nil
 }
 
@@ -103,6 +135,7 @@ func (p *BaseParty) round() Round {
 
 func (p *BaseParty) advance() {
 	p.rnd = p.rnd.NextRound()
+	p.rndStart = time.Now()
 }
 
 func (p *BaseParty) lock() {
@@ -113,11 +146,88 @@ func (p *BaseParty) unlock() {
 	p.mtx.Unlock()
 }
 
+func (p *BaseParty) outbound() chan<- Message {
+	return p.out
+}
+
+func (p *BaseParty) echoes() *echoState {
+	return &p.echo
+}
+
+func (p *BaseParty) record(msg ParsedMessage) {
+	if p.received == nil {
+		p.received = make(map[string][]ParsedMessage)
+	}
+	key := string(msg.GetFrom().Key)
+	for _, prev := range p.received[key] {
+		if prev == msg {
+			return
+		}
+	}
+	p.received[key] = append(p.received[key], msg)
+}
+
+func (p *BaseParty) runner() *Actor {
+	return p.actor
+}
+
+func (p *BaseParty) setRunner(a *Actor) {
+	p.actor = a
+}
+
+func (p *BaseParty) taskName() string {
+	return p.task
+}
+
+func (p *BaseParty) setTaskName(task string) {
+	p.task = task
+}
+
+func (p *BaseParty) roundStartedAt() time.Time {
+	return p.rndStart
+}
+
+func (p *BaseParty) aborted() *Error {
+	return p.abortErr
+}
+
+// setAborted stops the party; it drops its round and the messages that it has kept as evidence
+func (p *BaseParty) setAborted(err *Error) {
+	p.abortErr = err
+	p.rnd = nil
+	p.received = nil
+	p.echo = echoState{}
+}
+
+// evidenceFrom returns every message received from the culprits. It is the evidence of an error whose site did not
+// name the messages that the culprits are blamed for.
+func (p *BaseParty) evidenceFrom(culprits []*PartyID) []Evidence {
+	evidence := make([]Evidence, 0)
+	for _, culprit := range culprits {
+		if culprit == nil {
+			continue
+		}
+		for _, msg := range p.received[string(culprit.Key)] {
+			if ev, err := NewEvidence(msg); err == nil {
+				evidence = append(evidence, ev)
+			}
+		}
+	}
+	return evidence
+}
+
 // ----- //
 
-func BaseStart(p Party, task string, prepare ...func(Round) *Error) *Error {
+func BaseStart(p Party, task string, prepare ...func(Round) *Error) (err *Error) {
+	if rec := p.FirstRound().Params().Recorder(); rec != nil {
+		defer func() { rec.recordError(err) }()
+	}
 	p.lock()
 	defer p.unlock()
+	if err := p.aborted(); err != nil {
+		return err
+	}
+	p.setTaskName(task)
 	if p.PartyID() == nil || !p.PartyID().ValidateBasic() {
 		return p.WrapError(fmt.Errorf("could not start. this party has an invalid PartyID: %+v", p.PartyID()))
 	}
@@ -125,6 +235,9 @@ func BaseStart(p Party, task string, prepare ...func(Round) *Error) *Error {
 		return p.WrapError(errors.New("could not start. this party is in an unexpected state. use the constructor and Start()"))
 	}
 	round := p.FirstRound()
+	if err := validateParams(round); err != nil {
+		return p.WrapError(Classify(fmt.Errorf("could not start. %w", err), ErrInvalidParameters))
+	}
 	if err := p.setRound(round); err != nil {
 		return err
 	}
@@ -136,52 +249,207 @@ func BaseStart(p Party, task string, prepare ...func(Round) *Error) *Error {
 			return err
 		}
 	}
-	common.Logger.Infof("party %s: %s round %d starting", p.round().Params().PartyID(), task, 1)
-	defer func() {
-		common.Logger.Debugf("party %s: %s round %d finished", p.round().Params().PartyID(), task, 1)
-	}()
-	return p.round().Start()
+	return startRound(p, task)
 }
 
-// an implementation of Update that is shared across the different types of parties (keygen, signing, dynamic groups)
+// validateParams validates the parameters of the round, which for a resharing include both committees
+func validateParams(round Round) error {
+	if rs, ok := round.(interface{ ReSharingParams() *ReSharingParameters }); ok {
+		return rs.ReSharingParams().Validate()
+	}
+	return round.Params().Validate()
+}
+
+// an implementation of Update that is shared across the different types of parties (keygen, signing, dynamic groups).
+// It is a synchronous wrapper over the party's Actor: the message is queued on the actor, which processes it and runs
+// any rounds that it completes on its own goroutine, and the result is returned once it has been processed. A party
+// that is not run by an Actor gets one the first time that it is updated.
 func BaseUpdate(p Party, msg ParsedMessage, task string) (ok bool, err *Error) {
+	return runnerOf(p).process(func() (bool, *Error) {
+		return update(p, msg, task)
+	})
+}
+
+// update processes a message on the calling goroutine, which is the goroutine of the party's actor
+func update(p Party, msg ParsedMessage, task string) (ok bool, err *Error) {
+	if rec := p.FirstRound().Params().Recorder(); rec != nil && msg != nil {
+		rec.recordInbound(msg)
+		defer func() { rec.recordError(err) }()
+	}
 	// fast-fail on an invalid message; do not lock the mutex yet
 	if _, err := p.ValidateMessage(msg); err != nil {
 		return false, err
 	}
-	// lock the mutex. need this mtx unlock hook; L108 is recursive so cannot use defer
-	r := func(ok bool, err *Error) (bool, *Error) {
-		p.unlock()
-		return ok, err
-	}
 	p.lock() // data is written to P state below
-	common.Logger.Debugf("party %s received message: %s", p.PartyID(), msg.String())
-	if p.round() != nil {
-		common.Logger.Debugf("party %s round %d update: %s", p.PartyID(), p.round().RoundNumber(), msg.String())
+	defer p.unlock()
+	if err := p.aborted(); err != nil {
+		return false, err
+	}
+	if ok, err = deliver(p, msg, task); err != nil && len(err.Evidence()) == 0 {
+		err.WithEvidence(p.evidenceFrom(err.Culprits())...)
 	}
-	if ok, err := p.StoreMessage(msg); err != nil || !ok {
-		return r(false, err)
+	return ok, err
+}
+
+// WrapParseError wraps an error of Parameters.ParseWireMessage as an ErrBadMessage of the party, with the wire bytes
+// as evidence. The sender that the transport reported is blamed unless the error happened before the signature of the
+// message's envelope checked out, since anyone could have sent those bytes in its name.
+func WrapParseError(p Party, err error, wireBytes []byte, from *PartyID, isBroadcast bool) *Error {
+	params := p.FirstRound().Params()
+	sealed := params.authenticator != nil || (params.encryptionKey != nil && !isBroadcast)
+	evidence := Evidence{From: from, IsBroadcast: isBroadcast, WireBytes: wireBytes, Sealed: sealed}
+	var culprits []*PartyID
+	if !errors.As(err, new(unauthenticatedError)) {
+		culprits = append(culprits, from)
 	}
+	return p.WrapError(Classify(err, ErrBadMessage), culprits...).WithEvidence(evidence)
+}
+
+// deliver stores a validated message and then advances the party through every round that can proceed.
+// The caller must hold the party's lock.
+func deliver(p Party, msg ParsedMessage, task string) (bool, *Error) {
 	if p.round() != nil {
-		common.Logger.Debugf("party %s: %s round %d update", p.round().Params().PartyID(), task, p.round().RoundNumber())
+		roundLogger(p.round(), task).Debug("received message", "msg", msg.String())
+	}
+	echo := echoEnabled(p)
+	if _, isEcho := msg.Content().(*EchoMessage); isEcho {
+		if !echo {
+			return false, p.WrapError(Classify(errors.New("received an echo but echo broadcast is not enabled"), ErrBadMessage), msg.GetFrom()).WithMessages(msg)
+		}
+		if err := storeEcho(p, msg); err != nil {
+			return false, err
+		}
+		p.record(msg)
+	} else {
+		if ok, err := p.StoreMessage(msg); err != nil || !ok {
+			return false, err
+		}
+		p.record(msg)
+		if echo && msg.IsBroadcast() {
+			recordBroadcast(p, msg)
+		}
+	}
+	// messages are stored for every round, so those that arrived early are picked up by the rounds that follow
+	for p.round() != nil {
 		if _, err := p.round().Update(); err != nil {
-			return r(false, err)
+			return false, err
+		}
+		if !p.round().CanProceed() {
+			break
+		}
+		if echo {
+			// the round may only proceed once every peer has echoed the same broadcasts
+			if ready, err := echoRound(p, p.outbound()); err != nil || !ready {
+				return err == nil, err
+			}
 		}
-		if p.round().CanProceed() {
-			if p.advance(); p.round() != nil {
-				if err := p.round().Start(); err != nil {
-					return r(false, err)
-				}
-				rndNum := p.round().RoundNumber()
-				common.Logger.Infof("party %s: %s round %d started", p.round().Params().PartyID(), task, rndNum)
-			} else {
-				// finished! the round implementation will have sent the data through the `end` channel.
-				common.Logger.Infof("party %s: %s finished!", p.PartyID(), task)
+		roundFinished(p.round(), task, time.Since(p.roundStartedAt()))
+		if p.advance(); p.round() != nil {
+			if err := startRound(p, task); err != nil {
+				return false, err
 			}
-			p.unlock()                      // recursive so can't defer after return
-			return BaseUpdate(p, msg, task) // re-run round update or finish)
 		}
-		return r(true, nil)
+		// otherwise finished! the round implementation will have sent the data through the `end` channel.
+	}
+	return true, nil
+}
+
+// BaseAbort is an implementation of Abort that is shared across the different types of parties. It stops the party,
+// which then rejects every message, and calls `zeroize` to overwrite the secrets in the party's temp data and drop the
+// messages that it received. The returned Error wraps ErrAborted and the reason, whose culprits and evidence it keeps
+// if the reason is an *Error; aborting the party again returns the same Error.
+// A party zeroizes its temp data by itself once it has finished, but the secrets of a party that fails or is abandoned
+// are only overwritten once it is aborted.
+func BaseAbort(p Party, task string, reason error, zeroize func()) *Error {
+	p.lock()
+	defer p.unlock()
+	if err := p.aborted(); err != nil {
+		return err
+	}
+	if reason == nil {
+		reason = errors.New("no reason was given")
+	}
+	cause := Classify(fmt.Errorf("aborted: %w", reason), ErrAborted)
+	var culprits []*PartyID
+	var evidence []Evidence
+	var tErr *Error
+	if errors.As(reason, &tErr) {
+		culprits, evidence = tErr.Culprits(), tErr.Evidence()
+	}
+	var err *Error
+	if rnd := p.round(); rnd != nil {
+		err = rnd.WrapError(cause, culprits...)
+		roundLogger(rnd, task).Warn("party aborted", "reason", reason.Error())
+	} else {
+		err = NewError(cause, task, -1, p.PartyID(), culprits...)
+	}
+	err.WithEvidence(evidence...)
+	p.setAborted(err)
+	if zeroize != nil {
+		zeroize()
+	}
+	return err
+}
+
+// StoreMessageOnce stores msg in slot unless the sender already sent a message for it.
+// A byte-identical copy is ignored; a different message from the same sender is equivocation and the sender is blamed.
+func StoreMessageOnce(p Party, slot *ParsedMessage, msg ParsedMessage) (bool, *Error) {
+	if prev := *slot; prev != nil {
+		if prev.Type() == msg.Type() && proto.Equal(prev.Content(), msg.Content()) {
+			return true, nil
+		}
+		if rnd := p.round(); rnd != nil {
+			rnd.Params().Logger().Warn("conflicting messages from the same sender", "type", msg.Type(), "from", msg.GetFrom().String())
+		}
+		return false, p.WrapError(Classify(fmt.Errorf("received a conflicting %s message from the same sender", msg.Type()), ErrEquivocation), msg.GetFrom()).WithMessages(prev, msg)
+	}
+	*slot = msg
+	return true, nil
+}
+
+// roundLogger returns the party's Logger enriched with its task and current round
+func roundLogger(rnd Round, task string) Logger {
+	return rnd.Params().Logger().With("task", task, "round", rnd.RoundNumber())
+}
+
+// startRound starts the party's current round and reports it.
+// The final round outputs the result from Start, so it is also finished once Start returns.
+func startRound(p Party, task string) *Error {
+	rnd := p.round()
+	if err := rnd.Start(); err != nil {
+		return err
+	}
+	roundStarted(rnd, task)
+	if final, ok := rnd.(FinalRound); ok && final.IsFinal() {
+		roundFinished(rnd, task, time.Since(p.roundStartedAt()))
+	}
+	return nil
+}
+
+// finished reports whether the party has output its result, which the final round does as soon as it has started, or
+// has been aborted
+func finished(p Party) bool {
+	if p.aborted() != nil {
+		return true
+	}
+	rnd := p.round()
+	if rnd == nil {
+		return true
+	}
+	final, ok := rnd.(FinalRound)
+	return ok && final.IsFinal()
+}
+
+func roundStarted(rnd Round, task string) {
+	roundLogger(rnd, task).Info("round started")
+	if observer := rnd.Params().Observer(); observer != nil {
+		observer.RoundStarted(rnd.Params().PartyID(), task, rnd.RoundNumber())
+	}
+}
+
+func roundFinished(rnd Round, task string, elapsed time.Duration) {
+	roundLogger(rnd, task).Info("round finished", "elapsed", elapsed)
+	if observer := rnd.Params().Observer(); observer != nil {
+		observer.RoundFinished(rnd.Params().PartyID(), task, rnd.RoundNumber(), elapsed)
 	}
-	return r(true, nil)
 }
@@ -22,6 +22,10 @@ type (
 	PartyID struct {
 		*MessageWrapper_PartyID
 		Index int `json:"index"`
+		// IdentityKey is the party's ed25519 public key, used to authenticate its messages when an Authenticator is set
+		IdentityKey []byte `json:"identityKey,omitempty"`
+		// EncryptionKey is the party's X25519 public key, used to encrypt point-to-point messages sent to it
+		EncryptionKey []byte `json:"encryptionKey,omitempty"`
 	}
 
 	UnSortedPartyIDs []*PartyID
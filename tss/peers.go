@@ -6,6 +6,13 @@
 
 package tss
 
+import (
+	"crypto/elliptic"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
 type (
 	PeerContext struct {
 		partyIDs SortedPartyIDs
@@ -23,3 +30,79 @@ func (p2pCtx *PeerContext) IDs() SortedPartyIDs {
 func (p2pCtx *PeerContext) SetIDs(ids SortedPartyIDs) {
 	p2pCtx.partyIDs = ids
 }
+
+// Validate checks that the parties can be used as the Shamir evaluation points of the curve: the party keys must be
+// distinct and non-zero mod N, and each party's Index must be its position in the sorted list.
+func (p2pCtx *PeerContext) Validate(curve elliptic.Curve) error {
+	if p2pCtx == nil || len(p2pCtx.partyIDs) == 0 {
+		return errors.New("the peer context has no parties")
+	}
+	if curve == nil {
+		return errors.New("the curve is nil")
+	}
+	N := curve.Params().N
+	seen := make(map[string]*PartyID, len(p2pCtx.partyIDs))
+	for i, pID := range p2pCtx.partyIDs {
+		if !pID.ValidateBasic() {
+			return fmt.Errorf("party %d has an invalid PartyID: %v", i, pID)
+		}
+		if pID.Index != i {
+			return fmt.Errorf("party %s has index %d but is at position %d; sort the parties with SortPartyIDs", pID, pID.Index, i)
+		}
+		key := new(big.Int).Mod(pID.KeyInt(), N)
+		if key.Sign() == 0 {
+			return fmt.Errorf("party %s has a key that is zero mod N", pID)
+		}
+		if other, ok := seen[key.String()]; ok {
+			return fmt.Errorf("parties %s and %s have the same key mod N", other, pID)
+		}
+		seen[key.String()] = pID
+	}
+	return nil
+}
+
+// validateCommittee checks a committee of partyCount parties that can sign with threshold+1 of them
+func validateCommittee(curve elliptic.Curve, parties *PeerContext, partyCount, threshold int) error {
+	if err := parties.Validate(curve); err != nil {
+		return err
+	}
+	if len(parties.IDs()) != partyCount {
+		return fmt.Errorf("the peer context has %d parties but the party count is %d", len(parties.IDs()), partyCount)
+	}
+	if threshold < 1 || partyCount <= threshold {
+		return fmt.Errorf("the threshold %d is out of range for %d parties", threshold, partyCount)
+	}
+	return nil
+}
+
+// validateShareIDs checks that the explicit share IDs of the parties, if any, are distinct and not zero mod N
+func validateShareIDs(curve elliptic.Curve, parties *PeerContext, shareIDs []*big.Int) error {
+	if shareIDs == nil {
+		return nil
+	}
+	if len(shareIDs) != len(parties.IDs()) {
+		return fmt.Errorf("there are %d share IDs for %d parties", len(shareIDs), len(parties.IDs()))
+	}
+	N := curve.Params().N
+	seen := make(map[string]int, len(shareIDs))
+	for i, shareID := range shareIDs {
+		if shareID == nil {
+			return fmt.Errorf("party %s has no share ID", parties.IDs()[i])
+		}
+		id := new(big.Int).Mod(shareID, N)
+		if id.Sign() == 0 {
+			return fmt.Errorf("party %s has a share ID that is zero mod N", parties.IDs()[i])
+		}
+		if j, ok := seen[id.String()]; ok {
+			return fmt.Errorf("parties %s and %s have the same share ID mod N", parties.IDs()[j], parties.IDs()[i])
+		}
+		seen[id.String()] = i
+	}
+	return nil
+}
+
+// isMember reports whether the party is in the committee with the same index
+func isMember(parties *PeerContext, partyID *PartyID) bool {
+	member := parties.IDs().FindByKey(partyID.KeyInt())
+	return member != nil && member.Index == partyID.Index
+}
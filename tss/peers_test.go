@@ -0,0 +1,88 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SafeMPC/tss-lib/eddsa/keygen"
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+func TestPeerContextValidate(t *testing.T) {
+	ec := tss.S256()
+	withKeys := func(keys ...*big.Int) *tss.PeerContext {
+		ids := make(tss.UnSortedPartyIDs, len(keys))
+		for i, key := range keys {
+			ids[i] = tss.NewPartyID(key.String(), key.String(), key)
+		}
+		return tss.NewPeerContext(tss.SortPartyIDs(ids))
+	}
+	N := ec.Params().N
+
+	assert.NoError(t, tss.NewPeerContext(tss.GenerateTestPartyIDs(3)).Validate(ec))
+	assert.Error(t, tss.NewPeerContext(nil).Validate(ec), "no parties")
+	assert.Error(t, withKeys(big.NewInt(1), big.NewInt(2), big.NewInt(2)).Validate(ec), "duplicate keys")
+	assert.Error(t, withKeys(big.NewInt(1), new(big.Int).Add(N, big.NewInt(1))).Validate(ec), "keys equal mod N")
+	assert.Error(t, withKeys(big.NewInt(1), new(big.Int).Set(N)).Validate(ec), "a key that is zero mod N")
+
+	pIDs := tss.GenerateTestPartyIDs(3)
+	pIDs[1].Index = 2
+	assert.Error(t, tss.NewPeerContext(pIDs).Validate(ec), "inconsistent indexes")
+}
+
+func TestStartValidatesParameters(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(3)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	start := func(params *tss.Parameters) *tss.Error {
+		return keygen.NewLocalParty(params, make(chan tss.Message, len(pIDs)), make(chan *keygen.LocalPartySaveData, 1)).Start()
+	}
+
+	assert.Nil(t, start(tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[0], len(pIDs), 1)))
+	for name, params := range map[string]*tss.Parameters{
+		"party count":     tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[0], len(pIDs)+1, 1),
+		"threshold":       tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[0], len(pIDs), len(pIDs)),
+		"missing self":    tss.NewParameters(tss.Edwards(), tss.NewPeerContext(pIDs[:2]), pIDs[2], 2, 1),
+		"duplicate party": tss.NewParameters(tss.Edwards(), tss.NewPeerContext(tss.SortedPartyIDs{pIDs[0], pIDs[0]}), pIDs[0], 2, 1),
+	} {
+		err := start(params)
+		if assert.NotNil(t, err, name) {
+			assert.True(t, errors.Is(err, tss.ErrInvalidParameters), name)
+			assert.Equal(t, tss.CodeInvalidParameters, err.Code(), name)
+		}
+	}
+
+	// explicit share IDs must be given for every party and be distinct and not zero mod N
+	for name, shareIDs := range map[string][]*big.Int{
+		"share ID count":     tss.SequentialShareIDs(len(pIDs) - 1),
+		"duplicate share ID": {big.NewInt(1), big.NewInt(2), big.NewInt(2)},
+		"zero share ID":      {big.NewInt(1), big.NewInt(2), tss.Edwards().Params().N},
+	} {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[0], len(pIDs), 1)
+		params.SetShareIDs(shareIDs)
+		err := start(params)
+		if assert.NotNil(t, err, name) {
+			assert.True(t, errors.Is(err, tss.ErrInvalidParameters), name)
+		}
+	}
+
+	// a resharing party must be in one of the committees
+	newPIDs := tss.GenerateTestPartyIDs(3)
+	outsider := tss.GenerateTestPartyIDs(1)[0]
+	rgParams := tss.NewReSharingParameters(tss.Edwards(), p2pCtx, tss.NewPeerContext(newPIDs), pIDs[0], len(pIDs), 1, len(newPIDs), 1)
+	assert.NoError(t, rgParams.Validate())
+	rgParams.SetNewShareIDs(tss.SequentialShareIDs(len(newPIDs)))
+	assert.NoError(t, rgParams.Validate())
+	rgParams.SetNewShareIDs(tss.SequentialShareIDs(len(newPIDs) + 1))
+	assert.Error(t, rgParams.Validate())
+	rgParams = tss.NewReSharingParameters(tss.Edwards(), p2pCtx, tss.NewPeerContext(newPIDs), outsider, len(pIDs), 1, len(newPIDs), 1)
+	assert.Error(t, rgParams.Validate())
+}
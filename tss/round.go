@@ -17,3 +17,9 @@ type Round interface {
 	WaitingFor() []*PartyID
 	WrapError(err error, culprits ...*PartyID) *Error
 }
+
+// FinalRound is implemented by the last round of a protocol, which outputs the result from Start
+type FinalRound interface {
+	Round
+	IsFinal() bool
+}
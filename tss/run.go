@@ -0,0 +1,127 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss
+
+import (
+	"context"
+	"errors"
+)
+
+// Transport is the subset of a message transport that Run needs to drive a party.
+// It is satisfied by the tss/transport package.
+type Transport interface {
+	// Send routes an outbound message produced by the party to its recipients
+	Send(msg Message) error
+	// Receive blocks until the next inbound message for the party is available or ctx is done
+	Receive(ctx context.Context) ([]byte, *MessageRouting, error)
+}
+
+// Run starts the party and drives it to completion over the given transport.
+// `out` and `end` must be the channels that the party was constructed with; they should be buffered so that the party
+// never blocks on them once Run has returned.
+// Outbound messages are prepared with the party's Parameters.SealWireMessage and forwarded to the transport, and
+// inbound messages are fed to the party through UpdateFromBytes.
+// The result sent on `end` is returned once the party has finished. If ctx is done first the returned error's culprits
+// are the parties that this party was still waiting for.
+func Run[T any](ctx context.Context, party Party, transport Transport, out <-chan Message, end <-chan T) (T, *Error) {
+	var zero T
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errCh := make(chan *Error, 2)
+	fail := func(err *Error) {
+		select {
+		case errCh <- err:
+		default:
+		}
+	}
+
+	started := make(chan struct{})
+	go func() {
+		defer close(started)
+		if err := party.Start(); err != nil {
+			fail(err)
+		}
+	}()
+	go func() {
+		// messages are only fed to the party once its first round has been set
+		select {
+		case <-started:
+		case <-ctx.Done():
+			return
+		}
+		for {
+			wireBytes, routing, err := transport.Receive(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					fail(party.WrapError(err))
+				}
+				return
+			}
+			if routing == nil || routing.From == nil {
+				fail(party.WrapError(errors.New("transport returned a message without a sender")))
+				return
+			}
+			if _, err := party.UpdateFromBytes(wireBytes, routing.From, routing.IsBroadcast); err != nil {
+				fail(err)
+				return
+			}
+		}
+	}()
+
+	params := party.FirstRound().Params()
+	send := func(msg Message) *Error {
+		msg, err := params.SealWireMessage(msg)
+		if err != nil {
+			return party.WrapError(err)
+		}
+		if err := transport.Send(msg); err != nil {
+			return party.WrapError(err)
+		}
+		return nil
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			// WaitingFor takes the party's lock, which an in-flight update may hold while it writes to `out`
+			waiting := make(chan []*PartyID, 1)
+			go func() { waiting <- party.WaitingFor() }()
+			for {
+				select {
+				case culprits := <-waiting:
+					err := ctx.Err()
+					if errors.Is(err, context.DeadlineExceeded) {
+						err = Classify(err, ErrTimeout)
+					}
+					return zero, party.WrapError(err, culprits...)
+				case <-out: // the session is being abandoned
+				}
+			}
+
+		case err := <-errCh:
+			return zero, err
+
+		case msg := <-out:
+			if err := send(msg); err != nil {
+				return zero, err
+			}
+
+		case result := <-end:
+			// the party writes all of its messages to `out` before sending its result, so flush what is left
+			for {
+				select {
+				case msg := <-out:
+					if err := send(msg); err != nil {
+						return zero, err
+					}
+				default:
+					return result, nil
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SafeMPC/tss-lib/eddsa/keygen"
+	"github.com/SafeMPC/tss-lib/test"
+	"github.com/SafeMPC/tss-lib/tss"
+	"github.com/SafeMPC/tss-lib/tss/transport"
+)
+
+type runResult struct {
+	save *keygen.LocalPartySaveData
+	err  *tss.Error
+}
+
+// runKeygen runs EdDSA keygen for every party in pIDs except those listed in `absent`
+func runKeygen(ctx context.Context, t *testing.T, pIDs tss.SortedPartyIDs, configure func(int, *tss.Parameters), absent ...int) []runResult {
+	hub := transport.NewHub()
+	defer hub.Close()
+	p2pCtx := tss.NewPeerContext(pIDs)
+	skip := make(map[int]bool, len(absent))
+	for _, i := range absent {
+		skip[i] = true
+	}
+
+	results := make(chan runResult, len(pIDs))
+	running := 0
+	for i, pID := range pIDs {
+		conn, err := hub.Connect(pID)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		if skip[i] {
+			continue
+		}
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pID, len(pIDs), test.TestThreshold)
+		if configure != nil {
+			configure(i, params)
+		}
+		outCh := make(chan tss.Message, len(pIDs))
+		endCh := make(chan *keygen.LocalPartySaveData, 1)
+		P := keygen.NewLocalParty(params, outCh, endCh)
+		running++
+		go func() {
+			save, err := tss.Run(ctx, P, conn, outCh, endCh)
+			results <- runResult{save, err}
+		}()
+	}
+	out := make([]runResult, 0, running)
+	for ; running > 0; running-- {
+		out = append(out, <-results)
+	}
+	return out
+}
+
+func TestRun(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(test.TestParticipants)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	results := runKeygen(ctx, t, pIDs, nil)
+	assert.Len(t, results, len(pIDs))
+	for _, res := range results {
+		if assert.Nil(t, res.err) && assert.NotNil(t, res.save) {
+			assert.True(t, res.save.EDDSAPub.Equals(results[0].save.EDDSAPub))
+		}
+	}
+}
+
+func TestRunDeadlineCulprits(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(test.TestParticipants)
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	absent := len(pIDs) - 1
+	results := runKeygen(ctx, t, pIDs, nil, absent)
+	assert.Len(t, results, len(pIDs)-1)
+	for _, res := range results {
+		if assert.NotNil(t, res.err) {
+			assert.ErrorIs(t, res.err, context.DeadlineExceeded)
+			assert.ErrorIs(t, res.err, tss.ErrTimeout)
+			assert.Equal(t, []*tss.PartyID{pIDs[absent]}, res.err.Culprits())
+		}
+	}
+}
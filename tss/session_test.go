@@ -0,0 +1,76 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SafeMPC/tss-lib/crypto/vss"
+	"github.com/SafeMPC/tss-lib/eddsa/keygen"
+	"github.com/SafeMPC/tss-lib/test"
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+func TestRunWithSessionID(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(test.TestParticipants)
+	encKeys := encryptionKeys(t, pIDs)
+	idKeys := identities(t, pIDs)
+	sessionID := []byte("session 1")
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	results := runKeygen(ctx, t, pIDs, func(i int, params *tss.Parameters) {
+		params.SetSessionID(sessionID)
+		params.SetEncryptionKey(encKeys[i])
+		params.SetAuthenticator(newAuthenticator(t, sessionID, idKeys[i], pIDs))
+	})
+	assert.Len(t, results, len(pIDs))
+	for _, res := range results {
+		assert.Nil(t, res.err)
+	}
+}
+
+func TestSessionIDSeparatesSessions(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(2)
+	encKeys := encryptionKeys(t, pIDs)
+	idKeys := identities(t, pIDs)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	newParams := func(i int, sessionID string) *tss.Parameters {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[i], len(pIDs), 1)
+		params.SetEncryptionKey(encKeys[i])
+		if sessionID != "" {
+			params.SetSessionID([]byte(sessionID))
+		}
+		return params
+	}
+
+	// without a session ID the SSID nonce stays zero, as it was before session IDs
+	assert.Zero(t, newParams(0, "").SSIDNonce().Sign())
+	assert.NotZero(t, newParams(0, "a").SSIDNonce().Cmp(newParams(0, "b").SSIDNonce()))
+	assert.Zero(t, newParams(0, "a").SSIDNonce().Cmp(newParams(1, "a").SSIDNonce()))
+
+	share := &vss.Share{Threshold: 1, ID: big.NewInt(1), Share: big.NewInt(42)}
+	sealed, err := newParams(0, "a").SealWireMessage(keygen.NewKGRound2Message1(pIDs[1], pIDs[0], share))
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = newParams(1, "a").ParseWireMessage(wireBytes(t, sealed), pIDs[0], false)
+	assert.NoError(t, err)
+	_, err = newParams(1, "b").ParseWireMessage(wireBytes(t, sealed), pIDs[0], false)
+	assert.Error(t, err, "a message from another session must be rejected")
+
+	// envelopes must be sealed for the party's own session
+	params := newParams(0, "a")
+	params.SetAuthenticator(newAuthenticator(t, []byte("b"), idKeys[0], pIDs))
+	_, err = params.SealWireMessage(keygen.NewKGRound1Message(pIDs[0], big.NewInt(1)))
+	assert.Error(t, err)
+}
@@ -0,0 +1,662 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// The protocols that a SessionManager can run. Each is registered by the package that implements it, which must be
+// imported for its sessions to be created.
+const (
+	ProtocolECDSAKeygen    = "ecdsa-keygen"
+	ProtocolECDSASigning   = "ecdsa-signing"
+	ProtocolECDSAResharing = "ecdsa-resharing"
+	ProtocolEdDSAKeygen    = "eddsa-keygen"
+	ProtocolEdDSASigning   = "eddsa-signing"
+	ProtocolEdDSAResharing = "eddsa-resharing"
+)
+
+const (
+	// DefaultSessionTimeout is how long a session may go without a message before a SessionManager abandons it
+	DefaultSessionTimeout = 10 * time.Minute
+	// SessionIDLength is the length of the session IDs that a SessionManager assigns
+	SessionIDLength = 32
+	// DefaultMaxPendingBytes is the default limit on the total size of the messages that a SessionManager keeps for
+	// sessions that have not been created yet
+	DefaultMaxPendingBytes = 64 << 20
+
+	// the number of sessions that have not been created yet for which messages are kept
+	maxPendingSessions = 1024
+	outboundBufferSize = 256
+)
+
+var (
+	protocolsMtx sync.RWMutex
+	protocols    = make(map[string]ProtocolFactory)
+)
+
+type (
+	// ProtocolFactory creates the party of a new session from the protocol-specific input given to
+	// SessionManager.NewSession. The party must be constructed with the session's Params and Out channel, and with an
+	// `end` channel from SessionEnd.
+	ProtocolFactory func(session *Session, input any) (Party, error)
+
+	// SessionManager runs many protocol sessions at once. It creates the party of each session by protocol name,
+	// assigns session IDs, routes inbound messages to the right party and abandons sessions that stop making progress.
+	// The sealed outbound messages of every session are multiplexed onto Outbound, which must be drained.
+	// A session that has ended is kept, with its result, for as long as a session may go without a message.
+	SessionManager struct {
+		timeout         time.Duration
+		inboxSize       int
+		maxWireSize     int
+		maxPendingBytes int
+		// the identity keys of the peers, by party key, if early messages must be signed envelopes
+		identities map[string]ed25519.PublicKey
+		out        chan SessionMessage
+
+		mtx          sync.Mutex
+		sessions     map[string]*Session
+		pending      map[string]*pendingSession
+		pendingBytes int
+		closed       bool
+	}
+
+	// Session is one protocol run of a SessionManager; its party runs on an Actor
+	Session struct {
+		id       []byte
+		protocol string
+		params   *Parameters
+		manager  *SessionManager
+		actor    *Actor
+		out      chan Message
+		end      chan any
+		done     chan struct{}
+		active   atomic.Int64 // the time of the last message, in Unix nanoseconds
+
+		mtx    sync.Mutex
+		result any
+		err    *Error
+	}
+
+	// SessionMessage is an outbound message of a session, sealed with the session's Parameters.SealWireMessage.
+	// The transport must deliver it to SessionManager.Deliver with the session ID, unless the ID is carried in an
+	// authenticated envelope, which SessionManager.DeliverEnvelope reads.
+	SessionMessage struct {
+		SessionID []byte
+		Message
+	}
+
+	// SessionStatus is a snapshot of the progress of a session
+	SessionStatus struct {
+		ID       []byte
+		Protocol string
+		// Round is the round that the party is in, or 0 if it has not started or has finished
+		Round      int
+		WaitingFor []*PartyID
+		Finished   bool
+		// Err is the error that ended the session, if any
+		Err *Error
+	}
+
+	pendingSession struct {
+		since    time.Time
+		messages []pendingMessage
+		bytes    int
+	}
+
+	pendingMessage struct {
+		wireBytes   []byte
+		from        *PartyID
+		isBroadcast bool
+	}
+)
+
+// RegisterProtocol makes a protocol available to SessionManager.NewSession under the given name
+func RegisterProtocol(name string, factory ProtocolFactory) {
+	protocolsMtx.Lock()
+	defer protocolsMtx.Unlock()
+	protocols[name] = factory
+}
+
+func getProtocol(name string) (ProtocolFactory, bool) {
+	protocolsMtx.RLock()
+	defer protocolsMtx.RUnlock()
+	factory, ok := protocols[name]
+	return factory, ok
+}
+
+// NewSessionID returns a random session ID of SessionIDLength bytes
+func NewSessionID() ([]byte, error) {
+	id := make([]byte, SessionIDLength)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	return id, nil
+}
+
+// NewSessionManager creates a SessionManager that abandons a session once it has gone for `timeout` without a
+// message, or for DefaultSessionTimeout if `timeout` is not positive
+func NewSessionManager(timeout time.Duration) *SessionManager {
+	if timeout <= 0 {
+		timeout = DefaultSessionTimeout
+	}
+	return &SessionManager{
+		timeout:         timeout,
+		inboxSize:       DefaultInboxSize,
+		maxWireSize:     DefaultMaxWireSize,
+		maxPendingBytes: DefaultMaxPendingBytes,
+		out:             make(chan SessionMessage, outboundBufferSize),
+		sessions:        make(map[string]*Session),
+		pending:         make(map[string]*pendingSession),
+	}
+}
+
+// InboxSize is the number of messages that are queued for the party of a session, and that are kept for a session
+// that has not been created yet
+func (m *SessionManager) InboxSize() int {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	return m.inboxSize
+}
+
+// SetInboxSize sets the number of messages that are queued for the party of each session that is created afterwards;
+// the default is DefaultInboxSize
+func (m *SessionManager) SetInboxSize(size int) {
+	if size <= 0 {
+		size = DefaultInboxSize
+	}
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.inboxSize = size
+}
+
+// SetMaxWireSize sets the largest message, in wire bytes, that is kept for a session that has not been created yet;
+// the default is DefaultMaxWireSize. The party of a session parses messages under the limit of its own Parameters.
+func (m *SessionManager) SetMaxWireSize(size int) {
+	if size <= 0 {
+		size = DefaultMaxWireSize
+	}
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.maxWireSize = size
+}
+
+// SetMaxPendingBytes sets the limit on the total size of the messages that are kept for all sessions that have not
+// been created yet; the default is DefaultMaxPendingBytes
+func (m *SessionManager) SetMaxPendingBytes(size int) {
+	if size <= 0 {
+		size = DefaultMaxPendingBytes
+	}
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.maxPendingBytes = size
+}
+
+// RequireEnvelopes makes the manager keep a message for a session that has not been created yet only if it is an
+// envelope for that session that was signed by its sender, one of the peers. Each peer must carry its IdentityKey, as
+// for NewAuthenticator. The party of the session authenticates the envelope again once it has been created.
+func (m *SessionManager) RequireEnvelopes(peers ...*PartyID) error {
+	keys, err := identityKeys(peers)
+	if err != nil {
+		return fmt.Errorf("RequireEnvelopes: %w", err)
+	}
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.identities = keys
+	return nil
+}
+
+// Outbound receives the sealed outbound messages of every session
+func (m *SessionManager) Outbound() <-chan SessionMessage {
+	return m.out
+}
+
+// NewSession creates and starts the party of a session of the named protocol. The session ID is bound to `params`;
+// a new one is assigned if `sessionID` is empty, which the caller must then share with the other parties of the
+// session. `input` is specific to the protocol, e.g. a signing.SessionInput with the key and the message to sign.
+// Messages that were delivered for the session before it was created are passed to the party once it has started.
+func (m *SessionManager) NewSession(protocol string, sessionID []byte, params *Parameters, input any) (*Session, error) {
+	factory, ok := getProtocol(protocol)
+	if !ok {
+		return nil, fmt.Errorf("unknown protocol %q; import the package that implements it", protocol)
+	}
+	if params == nil {
+		return nil, errors.New("the session has no parameters")
+	}
+	if len(sessionID) == 0 {
+		var err error
+		if sessionID, err = NewSessionID(); err != nil {
+			return nil, err
+		}
+	}
+	if len(params.SessionID()) > 0 && !bytes.Equal(params.SessionID(), sessionID) {
+		return nil, errors.New("the parameters are bound to a different session")
+	}
+	params.SetSessionID(sessionID)
+	s := &Session{
+		id:       sessionID,
+		protocol: protocol,
+		params:   params,
+		manager:  m,
+		out:      make(chan Message, params.PartyCount()*2),
+		end:      make(chan any, 1),
+		done:     make(chan struct{}),
+	}
+	party, err := factory(s, input)
+	if err != nil {
+		return nil, err
+	}
+	s.touch()
+
+	m.mtx.Lock()
+	s.actor = NewActor(party, m.inboxSize)
+	if m.closed {
+		m.mtx.Unlock()
+		return nil, errors.New("the session manager has been closed")
+	}
+	if _, exists := m.sessions[string(sessionID)]; exists {
+		m.mtx.Unlock()
+		return nil, fmt.Errorf("session %x already exists", sessionID)
+	}
+	m.sessions[string(sessionID)] = s
+	pending := m.pending[string(sessionID)]
+	if pending != nil {
+		delete(m.pending, string(sessionID))
+		m.pendingBytes -= pending.bytes
+	}
+	// the early messages are queued before any others can be delivered; they fit unless the inbox size was reduced
+	// after they were kept, and any that are dropped are reported
+	if pending != nil {
+		for _, msg := range pending.messages {
+			if _, err := s.actor.UpdateFromBytes(msg.wireBytes, msg.from, msg.isBroadcast); err != nil {
+				params.Logger().Warn("dropped a message that was delivered before the session was created",
+					"session", fmt.Sprintf("%x", sessionID), "from", msg.from.String(), "err", err.Error())
+			}
+		}
+	}
+	m.mtx.Unlock()
+
+	if err := s.actor.Start(); err != nil {
+		s.finish(nil, err)
+	}
+	go s.run()
+	return s, nil
+}
+
+// Deliver routes the wire bytes of a message received for a session to its party. Messages for a session that has not
+// been created yet are kept until it is, for as long as a session may go without a message, within the limits of
+// SetMaxWireSize, SetMaxPendingBytes and RequireEnvelopes.
+func (m *SessionManager) Deliver(sessionID, wireBytes []byte, from *PartyID, isBroadcast bool) error {
+	if len(sessionID) == 0 {
+		return errors.New("the message has no session ID")
+	}
+	if from == nil {
+		return errors.New("the message has no sender")
+	}
+	m.mtx.Lock()
+	if m.closed {
+		m.mtx.Unlock()
+		return errors.New("the session manager has been closed")
+	}
+	s, ok := m.sessions[string(sessionID)]
+	if !ok {
+		defer m.mtx.Unlock()
+		return m.keepPending(sessionID, pendingMessage{wireBytes, from, isBroadcast})
+	}
+	m.mtx.Unlock()
+	return s.deliver(wireBytes, from, isBroadcast)
+}
+
+// DeliverEnvelope routes an authenticated envelope to the party of the session whose ID it carries, like Deliver.
+// The envelope is authenticated by the party.
+func (m *SessionManager) DeliverEnvelope(wireBytes []byte, from *PartyID, isBroadcast bool) error {
+	env := new(Envelope)
+	if err := proto.Unmarshal(wireBytes, env); err != nil {
+		return Classify(fmt.Errorf("envelope: %w", err), ErrBadMessage)
+	}
+	return m.Deliver(env.GetSessionId(), wireBytes, from, isBroadcast)
+}
+
+// Session returns the session with the given ID
+func (m *SessionManager) Session(sessionID []byte) (*Session, bool) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	s, ok := m.sessions[string(sessionID)]
+	return s, ok
+}
+
+// Sessions returns the status of every session, ordered by session ID
+func (m *SessionManager) Sessions() []SessionStatus {
+	m.mtx.Lock()
+	sessions := make([]*Session, 0, len(m.sessions))
+	for _, s := range m.sessions {
+		sessions = append(sessions, s)
+	}
+	m.mtx.Unlock()
+	sort.Slice(sessions, func(i, j int) bool { return bytes.Compare(sessions[i].id, sessions[j].id) < 0 })
+	statuses := make([]SessionStatus, len(sessions))
+	for i, s := range sessions {
+		statuses[i] = s.Status()
+	}
+	return statuses
+}
+
+// Remove forgets a session, aborting it if it is still running
+func (m *SessionManager) Remove(sessionID []byte) {
+	m.mtx.Lock()
+	s, ok := m.sessions[string(sessionID)]
+	delete(m.sessions, string(sessionID))
+	m.mtx.Unlock()
+	if ok {
+		s.abort(errors.New("the session was removed"))
+	}
+}
+
+// Close aborts every session that is still running; no sessions can be created afterwards
+func (m *SessionManager) Close() {
+	m.mtx.Lock()
+	m.closed = true
+	sessions := m.sessions
+	m.sessions = make(map[string]*Session)
+	m.pending = make(map[string]*pendingSession)
+	m.pendingBytes = 0
+	m.mtx.Unlock()
+	for _, s := range sessions {
+		s.abort(errors.New("the session manager was closed"))
+	}
+}
+
+// forget drops a session that has ended, unless it has been replaced or removed already
+func (m *SessionManager) forget(s *Session) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if m.sessions[string(s.id)] == s {
+		delete(m.sessions, string(s.id))
+	}
+}
+
+// keepPending keeps a message for a session that has not been created yet. The caller must hold the lock.
+// Anyone may send messages for a session that does not exist, so they are bounded in size and number and, if envelopes
+// are required, authenticated before they are kept.
+func (m *SessionManager) keepPending(sessionID []byte, msg pendingMessage) error {
+	if m.maxWireSize < len(msg.wireBytes) {
+		return Classify(fmt.Errorf("the message of %d bytes for unknown session %x exceeds the limit of %d bytes",
+			len(msg.wireBytes), sessionID, m.maxWireSize), ErrBadMessage)
+	}
+	if m.identities != nil {
+		if err := m.verifyEnvelope(sessionID, msg); err != nil {
+			return Classify(err, ErrBadMessage)
+		}
+	}
+	now := time.Now()
+	for id, pending := range m.pending {
+		if m.timeout <= now.Sub(pending.since) {
+			delete(m.pending, id)
+			m.pendingBytes -= pending.bytes
+		}
+	}
+	if m.maxPendingBytes < m.pendingBytes+len(msg.wireBytes) {
+		return Classify(fmt.Errorf("too many bytes are waiting for unknown sessions; dropped a message for session %x", sessionID), ErrInboxFull)
+	}
+	pending, ok := m.pending[string(sessionID)]
+	if !ok {
+		if maxPendingSessions <= len(m.pending) {
+			return fmt.Errorf("too many messages are waiting for unknown sessions; dropped a message for session %x", sessionID)
+		}
+		pending = &pendingSession{since: now}
+		m.pending[string(sessionID)] = pending
+	}
+	if m.inboxSize <= len(pending.messages) {
+		return Classify(fmt.Errorf("too many messages are waiting for unknown session %x", sessionID), ErrInboxFull)
+	}
+	pending.messages = append(pending.messages, msg)
+	pending.bytes += len(msg.wireBytes)
+	m.pendingBytes += len(msg.wireBytes)
+	return nil
+}
+
+// verifyEnvelope checks that a message kept for a session that has not been created yet is an envelope for the session
+// that was signed by its sender
+func (m *SessionManager) verifyEnvelope(sessionID []byte, msg pendingMessage) error {
+	env := new(Envelope)
+	if err := proto.Unmarshal(msg.wireBytes, env); err != nil {
+		return fmt.Errorf("envelope: %w", err)
+	}
+	if !bytes.Equal(env.GetSessionId(), sessionID) {
+		return errors.New("envelope: the message belongs to a different session")
+	}
+	return env.verifySignature(msg.from, m.identities)
+}
+
+// ----- //
+
+// SessionEnd returns the `end` channel that the party of a session must be constructed with; the result that the
+// party sends on it becomes the result of the session
+func SessionEnd[T any](s *Session) chan<- T {
+	end := make(chan T, 1)
+	go func() {
+		select {
+		case result := <-end:
+			s.end <- result
+		case <-s.done:
+		}
+	}()
+	return end
+}
+
+// ID is the ID of the session, which its parties share
+func (s *Session) ID() []byte {
+	return s.id
+}
+
+func (s *Session) Protocol() string {
+	return s.protocol
+}
+
+// Params are the parameters of the session's party
+func (s *Session) Params() *Parameters {
+	return s.params
+}
+
+// Out is the channel that the party of the session must send its messages on
+func (s *Session) Out() chan<- Message {
+	return s.out
+}
+
+// Party is the party of the session
+func (s *Session) Party() Party {
+	return s.actor.Party()
+}
+
+// Done is closed once the session has finished or failed
+func (s *Session) Done() <-chan struct{} {
+	return s.done
+}
+
+// Result returns the result of the party, such as its save data or signature, once the session has finished.
+// The error is set if the session failed.
+func (s *Session) Result() (any, *Error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.result, s.err
+}
+
+// Wait waits for the session to finish and returns its result
+func (s *Session) Wait(ctx context.Context) (any, *Error) {
+	select {
+	case <-s.done:
+		return s.Result()
+	case <-ctx.Done():
+		return nil, s.wrapError(ctx.Err())
+	}
+}
+
+// Status returns a snapshot of the progress of the session
+func (s *Session) Status() SessionStatus {
+	result, err := s.Result()
+	status := SessionStatus{
+		ID:       s.id,
+		Protocol: s.protocol,
+		Finished: result != nil,
+		Err:      err,
+	}
+	if result != nil || err != nil {
+		return status
+	}
+	p := s.Party()
+	status.WaitingFor = p.WaitingFor()
+	p.lock()
+	if rnd := p.round(); rnd != nil {
+		status.Round = rnd.RoundNumber()
+	}
+	p.unlock()
+	return status
+}
+
+func (s *Session) deliver(wireBytes []byte, from *PartyID, isBroadcast bool) error {
+	select {
+	case <-s.done:
+		return fmt.Errorf("session %x has ended", s.id)
+	default:
+	}
+	s.touch()
+	if _, err := s.actor.UpdateFromBytes(wireBytes, from, isBroadcast); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *Session) touch() {
+	s.active.Store(time.Now().UnixNano())
+}
+
+// run forwards the messages of the party to the manager until the session ends
+func (s *Session) run() {
+	timer := time.NewTimer(s.manager.timeout)
+	defer timer.Stop()
+	for {
+		select {
+		case <-s.done:
+			return
+
+		case msg := <-s.out:
+			if !s.send(msg) {
+				return
+			}
+
+		case result := <-s.end:
+			// the party sends all of its messages before its result, so forward what is left
+			for {
+				select {
+				case msg := <-s.out:
+					if !s.send(msg) {
+						return
+					}
+					continue
+				default:
+				}
+				break
+			}
+			s.finish(result, nil)
+			return
+
+		case err := <-s.actor.Errors():
+			s.finish(nil, err)
+			return
+
+		case <-timer.C:
+			idle := time.Since(time.Unix(0, s.active.Load()))
+			if idle < s.manager.timeout {
+				timer.Reset(s.manager.timeout - idle)
+				continue
+			}
+			err := Classify(fmt.Errorf("the session made no progress for %s", idle.Round(time.Millisecond)), ErrTimeout)
+			s.finish(nil, s.wrapError(err, s.Party().WaitingFor()...))
+			return
+		}
+	}
+}
+
+// send seals a message of the party and forwards it to the manager; it returns false if the session has ended
+func (s *Session) send(msg Message) bool {
+	s.touch()
+	sealed, err := s.params.SealWireMessage(msg)
+	if err != nil {
+		s.finish(nil, s.wrapError(err))
+		return false
+	}
+	select {
+	case s.manager.out <- SessionMessage{SessionID: s.id, Message: sealed}:
+		return true
+	case <-s.done:
+		return false
+	}
+}
+
+func (s *Session) abort(cause error) {
+	select {
+	case <-s.done:
+	default:
+		s.finish(nil, s.wrapError(cause))
+	}
+}
+
+// wrapError wraps an error in the current round of the party, which runs on the actor's goroutine
+func (s *Session) wrapError(err error, culprits ...*PartyID) *Error {
+	p := s.Party()
+	p.lock()
+	defer p.unlock()
+	return p.WrapError(err, culprits...)
+}
+
+// finish records the outcome of the session once and stops its party, which is aborted if the session failed
+func (s *Session) finish(result any, err *Error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	select {
+	case <-s.done:
+		return
+	default:
+	}
+	s.result, s.err = result, err
+	close(s.done)
+	s.actor.Stop()
+	if err != nil {
+		go s.wipe(err)
+	}
+	// the session is kept for a while, so that its status and result can still be looked up
+	time.AfterFunc(s.manager.timeout, func() { s.manager.forget(s) })
+}
+
+// wipe aborts the party of a failed session, which overwrites its secrets once the message that it is processing, if
+// any, has been processed
+func (s *Session) wipe(err *Error) {
+	aborted := make(chan struct{})
+	go func() {
+		defer close(aborted)
+		s.Party().Abort(err)
+	}()
+	for {
+		select {
+		case <-aborted:
+			return
+		case <-s.out: // the party may still be writing messages that are no longer sent
+		}
+	}
+}
@@ -0,0 +1,409 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SafeMPC/tss-lib/common"
+	ecdsakeygen "github.com/SafeMPC/tss-lib/ecdsa/keygen"
+	ecdsasigning "github.com/SafeMPC/tss-lib/ecdsa/signing"
+	"github.com/SafeMPC/tss-lib/eddsa/keygen"
+	"github.com/SafeMPC/tss-lib/eddsa/resharing"
+	"github.com/SafeMPC/tss-lib/eddsa/signing"
+	"github.com/SafeMPC/tss-lib/test"
+	"github.com/SafeMPC/tss-lib/tss"
+	"github.com/SafeMPC/tss-lib/tss/transport"
+)
+
+// routeSessions delivers the outbound messages of each manager, which is run by the party of the same index, to the
+// managers of their recipients until ctx is done. Envelopes are routed by the session ID that they carry.
+func routeSessions(ctx context.Context, t *testing.T, managers []*tss.SessionManager, envelopes bool) {
+	for _, m := range managers {
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case msg := <-m.Outbound():
+					bz, routing, err := msg.WireBytes()
+					if !assert.NoError(t, err) {
+						return
+					}
+					deliver := func(to int) {
+						// parties without a manager are absent
+						if to == routing.From.Index || len(managers) <= to {
+							return
+						}
+						if envelopes {
+							err = managers[to].DeliverEnvelope(bz, routing.From, routing.IsBroadcast)
+						} else {
+							err = managers[to].Deliver(msg.SessionID, bz, routing.From, routing.IsBroadcast)
+						}
+						assert.NoError(t, err)
+					}
+					if routing.To == nil {
+						for to := range managers {
+							deliver(to)
+						}
+					}
+					for _, to := range routing.To {
+						deliver(to.Index)
+					}
+				}
+			}
+		}()
+	}
+}
+
+// routeCommittees delivers the outbound messages of the managers of both committees of a resharing session through a
+// hub, which fans them out to the committees that they are for, until ctx is done
+func routeCommittees(ctx context.Context, t *testing.T, sessionID []byte, oldManagers, newManagers []*tss.SessionManager, oldPIDs, newPIDs tss.SortedPartyIDs) {
+	hub := transport.NewHub()
+	go func() {
+		<-ctx.Done()
+		_ = hub.Close()
+	}()
+	connect := func(m *tss.SessionManager, conn transport.Transport, err error) {
+		if !assert.NoError(t, err) {
+			return
+		}
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case msg := <-m.Outbound():
+					assert.NoError(t, hub.Send(msg.Message))
+				}
+			}
+		}()
+		go func() {
+			for {
+				bz, routing, err := conn.Receive(ctx)
+				if err != nil {
+					return
+				}
+				assert.NoError(t, m.Deliver(sessionID, bz, routing.From, routing.IsBroadcast))
+			}
+		}()
+	}
+	for j, m := range oldManagers {
+		conn, err := hub.ConnectOldCommittee(oldPIDs[j])
+		connect(m, conn, err)
+	}
+	for j, m := range newManagers {
+		conn, err := hub.Connect(newPIDs[j])
+		connect(m, conn, err)
+	}
+}
+
+func TestSessionManager(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(test.TestParticipants)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	keys := identities(t, pIDs)
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	managers := make([]*tss.SessionManager, len(pIDs))
+	for i := range pIDs {
+		managers[i] = tss.NewSessionManager(time.Minute)
+		defer managers[i].Close()
+	}
+	keygenCtx, stopKeygen := context.WithCancel(ctx)
+	defer stopKeygen()
+	routeSessions(keygenCtx, t, managers, false)
+
+	// the first party assigns the session ID; the others join later, so their first messages wait for their sessions
+	keygens := make([]*tss.Session, len(pIDs))
+	for i, pID := range pIDs {
+		var sessionID []byte
+		if 0 < i {
+			sessionID = keygens[0].ID()
+		}
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pID, len(pIDs), test.TestThreshold)
+		session, err := managers[i].NewSession(tss.ProtocolEdDSAKeygen, sessionID, params, nil)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Len(t, session.ID(), tss.SessionIDLength)
+		keygens[i] = session
+		time.Sleep(10 * time.Millisecond)
+	}
+	saves := make([]*keygen.LocalPartySaveData, len(pIDs))
+	for i, session := range keygens {
+		result, err := session.Wait(ctx)
+		if !assert.Nil(t, err) {
+			return
+		}
+		saves[i] = result.(*keygen.LocalPartySaveData)
+		assert.True(t, saves[i].EDDSAPub.Equals(saves[0].EDDSAPub))
+
+		status := managers[i].Sessions()
+		if assert.Len(t, status, 1) {
+			assert.True(t, status[0].Finished)
+			assert.Equal(t, tss.ProtocolEdDSAKeygen, status[0].Protocol)
+			assert.Nil(t, status[0].Err)
+		}
+		managers[i].Remove(session.ID())
+	}
+	stopKeygen()
+
+	// signing runs in a session that is sealed in envelopes, which carry the session ID
+	sessionID, err := tss.NewSessionID()
+	assert.NoError(t, err)
+	signers := pIDs[:test.TestThreshold+1]
+	signCtx := tss.NewPeerContext(signers)
+	sessions := make([]*tss.Session, len(signers))
+	routeSessions(ctx, t, managers[:len(signers)], true)
+	for i, pID := range signers {
+		params := tss.NewParameters(tss.Edwards(), signCtx, pID, len(signers), test.TestThreshold)
+		params.SetAuthenticator(newAuthenticator(t, sessionID, keys[i], signers))
+		input := signing.SessionInput{Msg: big.NewInt(42), Key: *saves[i]}
+		if sessions[i], err = managers[i].NewSession(tss.ProtocolEdDSASigning, sessionID, params, input); !assert.NoError(t, err) {
+			return
+		}
+	}
+	_, err = managers[0].NewSession(tss.ProtocolEdDSASigning, sessionID, tss.NewParameters(tss.Edwards(), signCtx, signers[0], len(signers), test.TestThreshold), signing.SessionInput{Msg: big.NewInt(42), Key: *saves[0]})
+	assert.Error(t, err, "a session ID must not be reused")
+
+	pk := edwards.PublicKey{Curve: tss.Edwards(), X: saves[0].EDDSAPub.X(), Y: saves[0].EDDSAPub.Y()}
+	for _, session := range sessions {
+		result, err := session.Wait(ctx)
+		if !assert.Nil(t, err) {
+			return
+		}
+		sig, pErr := edwards.ParseSignature(result.(*common.SignatureData).GetSignature())
+		if assert.NoError(t, pErr) {
+			assert.True(t, edwards.Verify(&pk, big.NewInt(42).Bytes(), sig.R, sig.S), "eddsa verify must pass")
+		}
+	}
+}
+
+func TestSessionManagerECDSASigning(t *testing.T) {
+	keys, signers, err := ecdsakeygen.LoadKeygenTestFixturesRandomSet(test.TestThreshold+1, test.TestParticipants)
+	if err != nil {
+		t.Skip("the test fixtures were not found; run the keygen tests first")
+	}
+	p2pCtx := tss.NewPeerContext(signers)
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	managers := make([]*tss.SessionManager, len(signers))
+	for i := range signers {
+		managers[i] = tss.NewSessionManager(time.Minute)
+		defer managers[i].Close()
+	}
+	routeSessions(ctx, t, managers, false)
+	sessionID, err := tss.NewSessionID()
+	assert.NoError(t, err)
+	sessions := make([]*tss.Session, len(signers))
+	for i, pID := range signers {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(signers), test.TestThreshold)
+		input := ecdsasigning.SessionInput{Msg: big.NewInt(42), Key: keys[i]}
+		if sessions[i], err = managers[i].NewSession(tss.ProtocolECDSASigning, sessionID, params, input); !assert.NoError(t, err) {
+			return
+		}
+	}
+
+	pk := ecdsa.PublicKey{Curve: tss.S256(), X: keys[0].ECDSAPub.X(), Y: keys[0].ECDSAPub.Y()}
+	for i, session := range sessions {
+		result, err := session.Wait(ctx)
+		if !assert.Nil(t, err) {
+			return
+		}
+		sig := result.(*common.SignatureData)
+		r, s := new(big.Int).SetBytes(sig.GetR()), new(big.Int).SetBytes(sig.GetS())
+		assert.True(t, ecdsa.Verify(&pk, big.NewInt(42).Bytes(), r, s), "ecdsa verify must pass")
+		status := managers[i].Sessions()
+		if assert.Len(t, status, 1) {
+			assert.True(t, status[0].Finished)
+			assert.Equal(t, tss.ProtocolECDSASigning, status[0].Protocol)
+		}
+	}
+}
+
+func TestSessionManagerResharing(t *testing.T) {
+	threshold, newThreshold := test.TestThreshold, test.TestThreshold
+	oldKeys, oldPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(threshold+1, test.TestParticipants)
+	if err != nil {
+		t.Skip("the test fixtures were not found; run the keygen tests first")
+	}
+	newPIDs := tss.GenerateTestPartyIDs(test.TestParticipants)
+	oldP2PCtx, newP2PCtx := tss.NewPeerContext(oldPIDs), tss.NewPeerContext(newPIDs)
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	newManagers := func(n int) []*tss.SessionManager {
+		managers := make([]*tss.SessionManager, n)
+		for i := range managers {
+			managers[i] = tss.NewSessionManager(time.Minute)
+		}
+		return managers
+	}
+	oldManagers, newCommitteeManagers := newManagers(len(oldPIDs)), newManagers(len(newPIDs))
+	for _, m := range append(append([]*tss.SessionManager{}, oldManagers...), newCommitteeManagers...) {
+		defer m.Close()
+	}
+	sessionID, err := tss.NewSessionID()
+	assert.NoError(t, err)
+	routeCommittees(ctx, t, sessionID, oldManagers, newCommitteeManagers, oldPIDs, newPIDs)
+
+	newSession := func(m *tss.SessionManager, pID *tss.PartyID, key keygen.LocalPartySaveData) *tss.Session {
+		params := tss.NewReSharingParameters(tss.Edwards(), oldP2PCtx, newP2PCtx, pID, test.TestParticipants, threshold, len(newPIDs), newThreshold)
+		session, err := m.NewSession(tss.ProtocolEdDSAResharing, sessionID, params.Parameters, resharing.SessionInput{Params: params, Key: key})
+		assert.NoError(t, err)
+		return session
+	}
+	// the new committee waits for the old one, which deals the new shares
+	newSessions := make([]*tss.Session, len(newPIDs))
+	for j, pID := range newPIDs {
+		newSessions[j] = newSession(newCommitteeManagers[j], pID, keygen.NewLocalPartySaveData(len(newPIDs)))
+	}
+	oldSessions := make([]*tss.Session, len(oldPIDs))
+	for j, pID := range oldPIDs {
+		oldSessions[j] = newSession(oldManagers[j], pID, oldKeys[j])
+	}
+	for _, session := range append(append([]*tss.Session{}, oldSessions...), newSessions...) {
+		if session == nil {
+			return
+		}
+	}
+
+	for _, session := range oldSessions {
+		_, err := session.Wait(ctx)
+		assert.Nil(t, err)
+	}
+	for _, session := range newSessions {
+		result, err := session.Wait(ctx)
+		if !assert.Nil(t, err) {
+			return
+		}
+		save := result.(*keygen.LocalPartySaveData)
+		assert.True(t, save.EDDSAPub.Equals(oldKeys[0].EDDSAPub), "the new committee must hold the same key")
+		assert.NotNil(t, save.Xi)
+	}
+}
+
+func TestSessionManagerTimeout(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(test.TestParticipants)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	absent := len(pIDs) - 1
+	managers := make([]*tss.SessionManager, len(pIDs))
+	for i := range pIDs {
+		managers[i] = tss.NewSessionManager(2 * time.Second)
+		defer managers[i].Close()
+	}
+	routeSessions(ctx, t, managers[:absent], false)
+	sessionID, err := tss.NewSessionID()
+	assert.NoError(t, err)
+	sessions := make([]*tss.Session, 0, absent)
+	for i, pID := range pIDs[:absent] {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pID, len(pIDs), test.TestThreshold)
+		session, err := managers[i].NewSession(tss.ProtocolEdDSAKeygen, sessionID, params, nil)
+		if !assert.NoError(t, err) {
+			return
+		}
+		sessions = append(sessions, session)
+	}
+	for i, session := range sessions {
+		_, err := session.Wait(ctx)
+		if assert.NotNil(t, err) {
+			assert.ErrorIs(t, err, tss.ErrTimeout)
+			assert.Equal(t, []*tss.PartyID{pIDs[absent]}, err.Culprits())
+		}
+		status := managers[i].Sessions()
+		if assert.Len(t, status, 1) {
+			assert.False(t, status[0].Finished)
+			assert.Equal(t, err, status[0].Err)
+		}
+	}
+	// a session that has ended is forgotten once it has been kept for the timeout
+	for _, m := range managers[:absent] {
+		assert.Eventually(t, func() bool { return len(m.Sessions()) == 0 }, 10*time.Second, 100*time.Millisecond)
+	}
+}
+
+func TestSessionManagerErrors(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(2)
+	params := tss.NewParameters(tss.Edwards(), tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), 1)
+	m := tss.NewSessionManager(0)
+
+	_, err := m.NewSession("schnorr-keygen", nil, params, nil)
+	assert.Error(t, err, "the protocol is unknown")
+	_, err = m.NewSession(tss.ProtocolEdDSASigning, nil, params, 42)
+	assert.Error(t, err, "the input is of the wrong type")
+	params.SetSessionID([]byte("other"))
+	_, err = m.NewSession(tss.ProtocolEdDSAKeygen, []byte("session"), params, nil)
+	assert.Error(t, err, "the parameters are bound to another session")
+
+	// a session that was not created yet keeps as many messages as fit in the inbox of its party
+	assert.Equal(t, tss.DefaultInboxSize, m.InboxSize())
+	m.SetInboxSize(8)
+	bz := wireBytes(t, keygen.NewKGRound1Message(pIDs[1], big.NewInt(1)))
+	for i := 0; i < m.InboxSize(); i++ {
+		assert.NoError(t, m.Deliver([]byte("session"), bz, pIDs[1], true))
+	}
+	assert.True(t, errors.Is(m.Deliver([]byte("session"), bz, pIDs[1], true), tss.ErrInboxFull))
+
+	m.Close()
+	_, err = m.NewSession(tss.ProtocolEdDSAKeygen, nil, tss.NewParameters(tss.Edwards(), tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), 1), nil)
+	assert.Error(t, err, "the manager has been closed")
+}
+
+func TestSessionManagerPendingLimits(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(2)
+	keys := identities(t, pIDs)
+	msg := keygen.NewKGRound1Message(pIDs[1], big.NewInt(1))
+	bz := wireBytes(t, msg)
+
+	// messages for unknown sessions are bounded in size, both each and in total
+	m := tss.NewSessionManager(0)
+	defer m.Close()
+	m.SetMaxWireSize(len(bz) - 1)
+	assert.True(t, errors.Is(m.Deliver([]byte("session"), bz, pIDs[1], true), tss.ErrBadMessage))
+	m.SetMaxWireSize(0)
+	m.SetMaxPendingBytes(3 * len(bz))
+	for _, id := range []string{"a", "b", "c"} {
+		assert.NoError(t, m.Deliver([]byte(id), bz, pIDs[1], true))
+	}
+	assert.True(t, errors.Is(m.Deliver([]byte("d"), bz, pIDs[1], true), tss.ErrInboxFull))
+
+	// the bytes of a session are released once it is created
+	params := tss.NewParameters(tss.Edwards(), tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), 1)
+	_, err := m.NewSession(tss.ProtocolEdDSAKeygen, []byte("a"), params, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, m.Deliver([]byte("d"), bz, pIDs[1], true))
+
+	// once envelopes are required, only envelopes for the session that their senders signed are kept
+	m = tss.NewSessionManager(0)
+	defer m.Close()
+	assert.Error(t, m.RequireEnvelopes(tss.GenerateTestPartyIDs(1)...), "the peers must have identity keys")
+	assert.NoError(t, m.RequireEnvelopes(pIDs...))
+	sessionID := []byte("session")
+	assert.True(t, errors.Is(m.Deliver(sessionID, bz, pIDs[1], true), tss.ErrBadMessage), "a plain message is not kept")
+	sealed, sErr := newAuthenticator(t, sessionID, keys[1], pIDs).Seal(msg)
+	assert.NoError(t, sErr)
+	assert.NoError(t, m.DeliverEnvelope(wireBytes(t, sealed), pIDs[1], true))
+	assert.True(t, errors.Is(m.Deliver([]byte("other"), wireBytes(t, sealed), pIDs[1], true), tss.ErrBadMessage),
+		"an envelope of another session is not kept")
+	forged, sErr := newAuthenticator(t, sessionID, keys[0], pIDs).Seal(msg)
+	assert.NoError(t, sErr)
+	assert.True(t, errors.Is(m.DeliverEnvelope(wireBytes(t, forged), pIDs[1], true), tss.ErrBadMessage),
+		"an envelope that the sender did not sign is not kept")
+}
@@ -0,0 +1,262 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"google.golang.org/protobuf/proto"
+)
+
+const snapshotVersion = 1
+
+var snapshotDomain = []byte("tss-lib snapshot v1")
+
+type (
+	// RoundState is the progress of a round that is captured in a snapshot
+	RoundState struct {
+		Number  int
+		Started bool
+		// OK holds the `ok` trackers of the round; re-sharing rounds have one per committee
+		OK [][]bool
+	}
+
+	// RestorableRound is a Round of a party that supports snapshots
+	RestorableRound interface {
+		Round
+		State() RoundState
+		Restore(state RoundState) error
+	}
+)
+
+// BaseSnapshot is shared by the Snapshot implementations of the different types of parties.
+// `capture` is called while the party is locked and returns the messages stored by the party and its encoded secrets.
+// The snapshot is encrypted under the parameters' snapshot key.
+func BaseSnapshot(p Party, task string, capture func() ([]ParsedMessage, []byte, error)) ([]byte, error) {
+	p.lock()
+	defer p.unlock()
+	if p.round() == nil {
+		return nil, errors.New("snapshot: the party is not running")
+	}
+	rnd, ok := p.round().(RestorableRound)
+	if !ok {
+		return nil, errors.New("snapshot: the party does not support snapshots")
+	}
+	params := rnd.Params()
+	aead, err := snapshotAEAD(params.SnapshotKey())
+	if err != nil {
+		return nil, err
+	}
+	messages, secrets, err := capture()
+	if err != nil {
+		return nil, fmt.Errorf("snapshot: %w", err)
+	}
+
+	state := rnd.State()
+	snap := &PartySnapshot{
+		PartyKey: params.PartyID().Key,
+		Round:    uint32(state.Number),
+		Started:  state.Started,
+		Secrets:  secrets,
+	}
+	for _, ok := range state.OK {
+		snap.Ok = append(snap.Ok, &PartySnapshot_Tracker{Ok: ok})
+	}
+	for _, msg := range messages {
+		if msg == nil {
+			continue
+		}
+		bz, _, err := msg.WireBytes()
+		if err != nil {
+			return nil, fmt.Errorf("snapshot: %w", err)
+		}
+		from := msg.GetFrom()
+		snap.Messages = append(snap.Messages, &PartySnapshot_StoredMessage{
+			FromId:      from.Id,
+			FromMoniker: from.Moniker,
+			FromKey:     from.Key,
+			FromIndex:   uint32(from.Index),
+			IsBroadcast: msg.IsBroadcast(),
+			WireBytes:   bz,
+		})
+	}
+	p.echoes().snapshot(snap)
+
+	plaintext, err := proto.Marshal(snap)
+	if err != nil {
+		return nil, err
+	}
+	sealed := &SealedSnapshot{Version: snapshotVersion, Task: task, Nonce: make([]byte, aead.NonceSize())}
+	// the nonce is not part of the party's computation, so it is not read through Rand, which may be recorded
+	if _, err = io.ReadFull(params.rand, sealed.Nonce); err != nil {
+		return nil, err
+	}
+	sealed.Ciphertext = aead.Seal(nil, sealed.Nonce, plaintext, sealed.additionalData())
+	return proto.Marshal(sealed)
+}
+
+// BaseRestore is shared by the Restore constructors of the different types of parties.
+// `restore` builds the party from the secrets encoded by its Snapshot; BaseRestore then puts the party back into the round
+// it was in, with the messages that it had stored. The restored party continues without starting the round again.
+func BaseRestore(params *Parameters, snapshot []byte, task string, restore func(secrets []byte) (Party, error)) (Party, error) {
+	sealed := new(SealedSnapshot)
+	if err := proto.Unmarshal(snapshot, sealed); err != nil {
+		return nil, fmt.Errorf("snapshot: %w", err)
+	}
+	if sealed.GetVersion() != snapshotVersion {
+		return nil, fmt.Errorf("snapshot: unsupported version %d", sealed.GetVersion())
+	}
+	if sealed.GetTask() != task {
+		return nil, fmt.Errorf("snapshot: the snapshot is of a %s party, not %s", sealed.GetTask(), task)
+	}
+	aead, err := snapshotAEAD(params.SnapshotKey())
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed.GetNonce()) != aead.NonceSize() {
+		return nil, errors.New("snapshot: invalid nonce")
+	}
+	plaintext, err := aead.Open(nil, sealed.GetNonce(), sealed.GetCiphertext(), sealed.additionalData())
+	if err != nil {
+		return nil, errors.New("snapshot: the snapshot could not be decrypted")
+	}
+	snap := new(PartySnapshot)
+	if err = proto.Unmarshal(plaintext, snap); err != nil {
+		return nil, fmt.Errorf("snapshot: %w", err)
+	}
+	if !bytes.Equal(snap.GetPartyKey(), params.PartyID().Key) {
+		return nil, errors.New("snapshot: the snapshot was taken by another party")
+	}
+
+	p, err := restore(snap.GetSecrets())
+	if err != nil {
+		return nil, fmt.Errorf("snapshot: %w", err)
+	}
+	p.setTaskName(task)
+	rnd := p.FirstRound()
+	for n := 1; n < int(snap.GetRound()); n++ {
+		if rnd = rnd.NextRound(); rnd == nil {
+			return nil, fmt.Errorf("snapshot: round %d does not exist", snap.GetRound())
+		}
+	}
+	restorable, ok := rnd.(RestorableRound)
+	if !ok {
+		return nil, errors.New("snapshot: the party does not support snapshots")
+	}
+	state := RoundState{Number: int(snap.GetRound()), Started: snap.GetStarted()}
+	for _, tracker := range snap.GetOk() {
+		state.OK = append(state.OK, tracker.GetOk())
+	}
+	if err = restorable.Restore(state); err != nil {
+		return nil, fmt.Errorf("snapshot: %w", err)
+	}
+	if err := p.setRound(rnd); err != nil {
+		return nil, err
+	}
+	for _, stored := range snap.GetMessages() {
+		from := &PartyID{
+			MessageWrapper_PartyID: &MessageWrapper_PartyID{
+				Id:      stored.GetFromId(),
+				Moniker: stored.GetFromMoniker(),
+				Key:     stored.GetFromKey(),
+			},
+			Index: int(stored.GetFromIndex()),
+		}
+		msg, err := ParseWireMessage(stored.GetWireBytes(), from, stored.GetIsBroadcast())
+		if err != nil {
+			return nil, fmt.Errorf("snapshot: %w", err)
+		}
+		if ok, err := p.StoreMessage(msg); err != nil {
+			return nil, err
+		} else if !ok {
+			return nil, fmt.Errorf("snapshot: the stored message %s could not be restored", msg)
+		}
+	}
+	if echoEnabled(p) {
+		if err := p.echoes().restore(snap, p.FirstRound().Params().Parties().IDs()); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// ----- //
+
+func snapshotAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != chacha20poly1305.KeySize {
+		return nil, fmt.Errorf("snapshot: a %d-byte snapshot key must be set in the parameters", chacha20poly1305.KeySize)
+	}
+	return chacha20poly1305.NewX(key)
+}
+
+func (s *SealedSnapshot) additionalData() []byte {
+	return append(append(append([]byte{}, snapshotDomain...), byte(s.GetVersion())), s.GetTask()...)
+}
+
+func (es *echoState) snapshot(snap *PartySnapshot) {
+	for round, digests := range es.digests {
+		for from, digest := range digests {
+			rec := &PartySnapshot_EchoRecord{Round: uint32(round), Party: uint32(from), Digests: [][]byte{digest}}
+			if envelope, ok := es.envelopes[round][from]; ok {
+				rec.Envelopes = [][]byte{envelope}
+			}
+			snap.EchoDigests = append(snap.EchoDigests, rec)
+		}
+	}
+	for round, echoes := range es.echoes {
+		for from, msg := range echoes {
+			echo := msg.Content().(*EchoMessage)
+			snap.Echoes = append(snap.Echoes, &PartySnapshot_EchoRecord{Round: uint32(round), Party: uint32(from), Digests: echo.GetDigests(), Envelopes: echo.GetEnvelopes()})
+		}
+	}
+	for round, sent := range es.sent {
+		if sent {
+			snap.EchoesSent = append(snap.EchoesSent, uint32(round))
+		}
+	}
+}
+
+// restore restores the echo state of a snapshot of a party with the given peers; the echoes are restored as messages
+// of the peers that sent them, but not in the envelopes that they were received in
+func (es *echoState) restore(snap *PartySnapshot, peers []*PartyID) error {
+	for _, rec := range snap.GetEchoDigests() {
+		round := int(rec.GetRound())
+		if es.digests[round] == nil {
+			es.digests[round] = make(map[int][]byte)
+		}
+		if len(rec.GetDigests()) == 1 {
+			es.digests[round][int(rec.GetParty())] = rec.GetDigests()[0]
+		}
+		if len(rec.GetEnvelopes()) == 1 {
+			if es.envelopes[round] == nil {
+				es.envelopes[round] = make(map[int][]byte)
+			}
+			es.envelopes[round][int(rec.GetParty())] = rec.GetEnvelopes()[0]
+		}
+	}
+	for _, rec := range snap.GetEchoes() {
+		round := int(rec.GetRound())
+		if int(rec.GetParty()) >= len(peers) {
+			return fmt.Errorf("snapshot: the echo of party %d is not from a peer", rec.GetParty())
+		}
+		if es.echoes[round] == nil {
+			es.echoes[round] = make(map[int]ParsedMessage)
+		}
+		echo := &EchoMessage{Round: rec.GetRound(), Digests: rec.GetDigests(), Envelopes: rec.GetEnvelopes()}
+		meta := MessageRouting{From: peers[rec.GetParty()], IsBroadcast: true}
+		es.echoes[round][int(rec.GetParty())] = NewMessage(meta, echo, NewMessageWrapper(meta, echo))
+	}
+	for _, round := range snap.GetEchoesSent() {
+		es.sent[int(round)] = true
+	}
+	return nil
+}
@@ -0,0 +1,447 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// TranscriptVersion is the version of the transcript format written by this release.
+// Transcripts of an older version remain readable; those of a newer version are rejected.
+const TranscriptVersion = 1
+
+type (
+	// Recorder records a Transcript of a party: the messages that it receives and sends and the bytes that it reads
+	// from Parameters.Rand and Parameters.PartialKeyRand. Set it with Parameters.SetRecorder before the party starts.
+	//
+	// Inbound messages are recorded when they are given to the party, and outbound messages when they are sealed with
+	// Parameters.SealWireMessage, which tss.Run and the SessionManager do. While a Recorder is set the party does its
+	// work on its own goroutine so that it reads its random sources in the same order when it is replayed; pre-parameters
+	// are still generated concurrently, so an ECDSA keygen or re-sharing can only be replayed if they were supplied.
+	//
+	// A transcript holds everything the party needed to compute its secrets; store it as securely as the key itself.
+	Recorder struct {
+		mtx        sync.Mutex
+		transcript Transcript
+	}
+
+	// Transcript is the recording of a party, which Replay re-runs offline.
+	// It is serialized to JSON with Marshal and read back with UnmarshalTranscript.
+	Transcript struct {
+		Version   int                 `json:"version"`
+		PartyID   *PartyID            `json:"party_id"`
+		SessionID []byte              `json:"session_id,omitempty"`
+		Inbound   []TranscriptMessage `json:"inbound"`
+		Outbound  []TranscriptMessage `json:"outbound"`
+		// the bytes read from Parameters.Rand and Parameters.PartialKeyRand, in order
+		Rand           []byte `json:"rand"`
+		PartialKeyRand []byte `json:"partial_key_rand"`
+		// the error that the party failed with, if any
+		Error *AbortReport `json:"error,omitempty"`
+	}
+
+	// TranscriptMessage is a message received or sent by a recorded party. WireBytes are those of the message itself,
+	// before it is encrypted or sealed in an envelope.
+	TranscriptMessage struct {
+		Type        string     `json:"type"`
+		From        *PartyID   `json:"from"`
+		To          []*PartyID `json:"to,omitempty"`
+		IsBroadcast bool       `json:"is_broadcast"`
+		WireBytes   []byte     `json:"wire_bytes"`
+	}
+
+	// Replay re-runs a recorded party offline, one step at a time: the first step starts the party and each further
+	// step gives it the next inbound message. The messages that the party sends are compared with those that were
+	// recorded, and the first step at which the party computes something else is reported as a Divergence.
+	Replay struct {
+		transcript *Transcript
+		party      Party
+		out        <-chan Message
+		params     *Parameters
+		rand       *replayReader
+		partialKey *replayReader
+		// the recorded outbound messages that were not sent yet, by type and recipients
+		outbound   map[string][]TranscriptMessage
+		step       int
+		round      int
+		err        *Error
+		divergence *Divergence
+	}
+
+	// ReplayReport is the result of a Replay
+	ReplayReport struct {
+		// Steps is the number of steps that were replayed
+		Steps int
+		// Round is the last round that the replayed party started
+		Round int
+		// Err is the error that the replayed party failed with, if any
+		Err *Error
+		// Divergence is the first difference between the replay and the transcript, or nil if there was none
+		Divergence *Divergence
+	}
+
+	// Divergence is the first point at which a replayed party computed something other than what was recorded
+	Divergence struct {
+		Step   int
+		Round  int
+		Reason string
+	}
+
+	recordingReader struct {
+		src        io.Reader
+		rec        *Recorder
+		partialKey bool
+	}
+
+	replayReader struct {
+		bz        []byte
+		exhausted bool
+	}
+
+	// replayObserver attributes the messages that the replayed party sends to the round that it started
+	replayObserver struct {
+		Observer
+		replay *Replay
+	}
+
+	// inlineExecutor runs every function on the calling goroutine
+	inlineExecutor struct{}
+)
+
+var errRandExhausted = errors.New("the party read more randomness than was recorded")
+
+// NewRecorder creates a Recorder for the party with the given ID
+func NewRecorder(partyID *PartyID) *Recorder {
+	return &Recorder{transcript: Transcript{Version: TranscriptVersion, PartyID: partyID}}
+}
+
+// Transcript returns a copy of what was recorded so far
+func (rec *Recorder) Transcript() *Transcript {
+	rec.mtx.Lock()
+	defer rec.mtx.Unlock()
+	t := rec.transcript
+	t.Inbound = append([]TranscriptMessage(nil), t.Inbound...)
+	t.Outbound = append([]TranscriptMessage(nil), t.Outbound...)
+	t.Rand = append([]byte(nil), t.Rand...)
+	t.PartialKeyRand = append([]byte(nil), t.PartialKeyRand...)
+	return &t
+}
+
+// recordError records the error that the party failed with, unless it was aborted from outside.
+// Only the first error is kept.
+func (rec *Recorder) recordError(err *Error) {
+	if err == nil || errors.Is(err, ErrAborted) {
+		return
+	}
+	rec.mtx.Lock()
+	defer rec.mtx.Unlock()
+	if rec.transcript.Error == nil {
+		rec.transcript.Error = err.Report()
+	}
+}
+
+func (rec *Recorder) setSessionID(sessionID []byte) {
+	rec.mtx.Lock()
+	defer rec.mtx.Unlock()
+	rec.transcript.SessionID = append([]byte(nil), sessionID...)
+}
+
+func (rec *Recorder) recordInbound(msg ParsedMessage) {
+	bz, routing, err := msg.WireBytes()
+	if err != nil {
+		return
+	}
+	rec.mtx.Lock()
+	defer rec.mtx.Unlock()
+	rec.transcript.Inbound = append(rec.transcript.Inbound, TranscriptMessage{
+		Type:        msg.Type(),
+		From:        routing.From,
+		To:          routing.To,
+		IsBroadcast: routing.IsBroadcast,
+		WireBytes:   bz,
+	})
+}
+
+func (rec *Recorder) recordOutbound(msg Message) error {
+	bz, routing, err := msg.WireBytes()
+	if err != nil {
+		return err
+	}
+	rec.mtx.Lock()
+	defer rec.mtx.Unlock()
+	rec.transcript.Outbound = append(rec.transcript.Outbound, TranscriptMessage{
+		Type:        msg.Type(),
+		From:        routing.From,
+		To:          routing.To,
+		IsBroadcast: routing.IsBroadcast,
+		WireBytes:   bz,
+	})
+	return nil
+}
+
+func (r recordingReader) Read(p []byte) (int, error) {
+	n, err := r.src.Read(p)
+	r.rec.mtx.Lock()
+	defer r.rec.mtx.Unlock()
+	if r.partialKey {
+		r.rec.transcript.PartialKeyRand = append(r.rec.transcript.PartialKeyRand, p[:n]...)
+	} else {
+		r.rec.transcript.Rand = append(r.rec.transcript.Rand, p[:n]...)
+	}
+	return n, err
+}
+
+// Marshal serializes the transcript to JSON
+func (t *Transcript) Marshal() ([]byte, error) {
+	return json.Marshal(t)
+}
+
+// UnmarshalTranscript reads a transcript that was serialized with Marshal by this or an earlier release
+func UnmarshalTranscript(bz []byte) (*Transcript, error) {
+	t := new(Transcript)
+	if err := json.Unmarshal(bz, t); err != nil {
+		return nil, err
+	}
+	if t.Version < 1 || TranscriptVersion < t.Version {
+		return nil, fmt.Errorf("unsupported transcript version %d; this release reads versions 1 to %d", t.Version, TranscriptVersion)
+	}
+	if t.PartyID == nil || !t.PartyID.ValidateBasic() {
+		return nil, errors.New("the transcript has no valid party ID")
+	}
+	return t, nil
+}
+
+// NewReplay prepares to re-run a recorded party. `party` must be a new party that was constructed like the recorded
+// one, with the same inputs, and `out` must be the channel that it was constructed with, buffered to hold the messages
+// of a round.
+// Its Parameters are set up to read the recorded randomness; they must not be used for another party.
+func NewReplay(transcript *Transcript, party Party, out <-chan Message) (*Replay, error) {
+	if transcript.Version < 1 || TranscriptVersion < transcript.Version {
+		return nil, fmt.Errorf("unsupported transcript version %d", transcript.Version)
+	}
+	params := party.FirstRound().Params()
+	if transcript.PartyID == nil || params.PartyID().KeyInt().Cmp(transcript.PartyID.KeyInt()) != 0 {
+		return nil, errors.New("the transcript was recorded by another party")
+	}
+	r := &Replay{
+		transcript: transcript,
+		party:      party,
+		out:        out,
+		params:     params,
+		rand:       &replayReader{bz: transcript.Rand},
+		partialKey: &replayReader{bz: transcript.PartialKeyRand},
+		outbound:   make(map[string][]TranscriptMessage),
+	}
+	for _, msg := range transcript.Outbound {
+		key := outboundKey(msg.Type, msg.To)
+		r.outbound[key] = append(r.outbound[key], msg)
+	}
+	if transcript.SessionID != nil {
+		params.SetSessionID(transcript.SessionID)
+	}
+	params.SetRand(r.rand)
+	params.SetPartialKeyRand(r.partialKey)
+	// a recorder makes the party do its work in the order in which it was recorded
+	params.SetRecorder(NewRecorder(params.PartyID()))
+	observer := params.Observer()
+	if observer == nil {
+		observer = NoopObserver{}
+	}
+	params.SetObserver(replayObserver{Observer: observer, replay: r})
+	return r, nil
+}
+
+// Step replays the next step and reports whether there are more to replay.
+// Replay stops at the first divergence or once the party fails.
+func (r *Replay) Step() bool {
+	if !r.more() {
+		return false
+	}
+	step := r.step
+	r.step++
+	err := r.run(step)
+	r.drain()
+	if r.rand.exhausted || r.partialKey.exhausted {
+		r.diverge(step, r.round, errRandExhausted.Error())
+		return false
+	}
+	if err != nil {
+		r.err = err
+		round := r.round
+		if 0 < err.Round() {
+			round = err.Round()
+		}
+		recorded := r.transcript.Error
+		switch {
+		case recorded == nil:
+			r.diverge(step, round, fmt.Sprintf("the party failed: %v", err))
+		case recorded.Code != err.Code() || recorded.Round != err.Round():
+			r.diverge(step, round, fmt.Sprintf("the party failed with %s in round %d, but it was recorded failing with %s in round %d",
+				err.Code(), err.Round(), recorded.Code, recorded.Round))
+		}
+		return false
+	}
+	if !r.more() {
+		r.finish()
+	}
+	return r.more()
+}
+
+// Run replays every step and reports the result
+func (r *Replay) Run() *ReplayReport {
+	for r.Step() {
+	}
+	return r.Report()
+}
+
+// Report reports the result of the steps replayed so far
+func (r *Replay) Report() *ReplayReport {
+	return &ReplayReport{Steps: r.step, Round: r.round, Err: r.err, Divergence: r.divergence}
+}
+
+func (r *Replay) more() bool {
+	return r.divergence == nil && r.err == nil && r.step <= len(r.transcript.Inbound)
+}
+
+// run runs a step, turning the panic of a party that ran out of recorded randomness into an error
+func (r *Replay) run(step int) (err *Error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			if !r.rand.exhausted && !r.partialKey.exhausted {
+				panic(recovered)
+			}
+			err = r.party.WrapError(errRandExhausted)
+		}
+	}()
+	if step == 0 {
+		return r.party.Start()
+	}
+	recorded := r.transcript.Inbound[step-1]
+	msg, pErr := ParseWireMessage(recorded.WireBytes, recorded.From, recorded.IsBroadcast)
+	if pErr != nil {
+		return r.party.WrapError(Classify(pErr, ErrBadMessage), recorded.From)
+	}
+	_, err = r.party.Update(msg)
+	return err
+}
+
+// finish checks, once every step has been replayed, that the party did everything that was recorded
+func (r *Replay) finish() {
+	if r.transcript.Error != nil {
+		r.diverge(r.step-1, r.round, fmt.Sprintf("the party was recorded failing with %s, but it did not", r.transcript.Error.Code))
+		return
+	}
+	keys := make([]string, 0, len(r.outbound))
+	for key, queue := range r.outbound {
+		if len(queue) > 0 {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) > 0 {
+		sort.Strings(keys)
+		r.diverge(r.step-1, r.round, fmt.Sprintf("the party did not send the recorded %s message", r.outbound[keys[0]][0].Type))
+		return
+	}
+	if left := len(r.rand.bz) + len(r.partialKey.bz); left > 0 {
+		r.diverge(r.step-1, r.round, fmt.Sprintf("the party read %d bytes less randomness than was recorded", left))
+	}
+}
+
+// drain compares the messages that the party sent with those that were recorded
+func (r *Replay) drain() {
+	for {
+		select {
+		case msg := <-r.out:
+			r.check(msg)
+		default:
+			return
+		}
+	}
+}
+
+func (r *Replay) check(msg Message) {
+	if r.divergence != nil {
+		return
+	}
+	step := r.step - 1
+	key := outboundKey(msg.Type(), msg.GetTo())
+	queue := r.outbound[key]
+	if len(queue) == 0 {
+		r.diverge(step, r.round, fmt.Sprintf("the party sent a %s message that was not recorded", msg.Type()))
+		return
+	}
+	r.outbound[key] = queue[1:]
+	bz, routing, err := msg.WireBytes()
+	if err != nil {
+		r.diverge(step, r.round, fmt.Sprintf("the party sent a %s message that cannot be serialized: %v", msg.Type(), err))
+		return
+	}
+	sent, err := ParseWireMessage(bz, routing.From, routing.IsBroadcast)
+	if err != nil {
+		r.diverge(step, r.round, fmt.Sprintf("the party sent a %s message that cannot be parsed: %v", msg.Type(), err))
+		return
+	}
+	recorded, err := ParseWireMessage(queue[0].WireBytes, queue[0].From, queue[0].IsBroadcast)
+	if err != nil {
+		r.diverge(step, r.round, fmt.Sprintf("the recorded %s message cannot be parsed: %v", msg.Type(), err))
+		return
+	}
+	if !proto.Equal(sent.Content(), recorded.Content()) {
+		r.diverge(step, r.round, fmt.Sprintf("the party sent a %s message that differs from the recorded one", msg.Type()))
+	}
+}
+
+func (r *Replay) diverge(step, round int, reason string) {
+	if r.divergence == nil {
+		r.divergence = &Divergence{Step: step, Round: round, Reason: reason}
+	}
+}
+
+func (d *Divergence) String() string {
+	return fmt.Sprintf("step %d, round %d: %s", d.Step, d.Round, d.Reason)
+}
+
+func outboundKey(msgType string, to []*PartyID) string {
+	if to == nil {
+		return msgType
+	}
+	keys := make([]string, len(to))
+	for i, pID := range to {
+		keys[i] = hex.EncodeToString(pID.Key)
+	}
+	return msgType + "/" + strings.Join(keys, ",")
+}
+
+func (r *replayReader) Read(p []byte) (int, error) {
+	if len(r.bz) == 0 && len(p) > 0 {
+		r.exhausted = true
+		return 0, io.ErrUnexpectedEOF
+	}
+	n := copy(p, r.bz)
+	r.bz = r.bz[n:]
+	return n, nil
+}
+
+func (o replayObserver) RoundStarted(party *PartyID, task string, round int) {
+	// the messages of a round are sent before it is reported started
+	o.replay.round = round
+	o.replay.drain()
+	o.Observer.RoundStarted(party, task, round)
+}
+
+func (inlineExecutor) Go(f func()) {
+	f()
+}
@@ -0,0 +1,129 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SafeMPC/tss-lib/eddsa/keygen"
+	"github.com/SafeMPC/tss-lib/test"
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+// replayKeygen replays an EdDSA keygen transcript recorded by pIDs[0] and returns the report and the save data, if
+// the replayed party output any
+func replayKeygen(t *testing.T, pIDs tss.SortedPartyIDs, transcript *tss.Transcript) (*tss.ReplayReport, *keygen.LocalPartySaveData) {
+	params := tss.NewParameters(tss.Edwards(), tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), test.TestThreshold)
+	out := make(chan tss.Message, len(pIDs))
+	end := make(chan *keygen.LocalPartySaveData, 1)
+	replay, err := tss.NewReplay(transcript, keygen.NewLocalParty(params, out, end), out)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	report := replay.Run()
+	select {
+	case save := <-end:
+		return report, save
+	default:
+		return report, nil
+	}
+}
+
+func TestTranscriptReplay(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(test.TestParticipants)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	rec := tss.NewRecorder(pIDs[0])
+	results := runKeygen(ctx, t, pIDs, func(i int, params *tss.Parameters) {
+		if i == 0 {
+			params.SetRecorder(rec)
+		}
+	})
+	for _, res := range results {
+		if !assert.Nil(t, res.err) {
+			return
+		}
+	}
+	bz, err := rec.Transcript().Marshal()
+	assert.NoError(t, err)
+	transcript, err := tss.UnmarshalTranscript(bz)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, tss.TranscriptVersion, transcript.Version)
+	assert.NotEmpty(t, transcript.Rand)
+	assert.NotEmpty(t, transcript.PartialKeyRand)
+	assert.Nil(t, transcript.Error)
+
+	report, save := replayKeygen(t, pIDs, transcript)
+	assert.Nil(t, report.Divergence)
+	assert.Nil(t, report.Err)
+	assert.Equal(t, len(transcript.Inbound)+1, report.Steps)
+	if assert.NotNil(t, save) {
+		assert.True(t, save.EDDSAPub.Equals(results[0].save.EDDSAPub))
+	}
+
+	// other randomness changes the commitment sent in the first round
+	tampered := *transcript
+	tampered.Rand = make([]byte, len(transcript.Rand))
+	for i, b := range transcript.Rand {
+		tampered.Rand[i] = b ^ 1
+	}
+	report, _ = replayKeygen(t, pIDs, &tampered)
+	if assert.NotNil(t, report.Divergence) {
+		assert.Equal(t, 0, report.Divergence.Step)
+		assert.Equal(t, 1, report.Divergence.Round)
+	}
+
+	// a decommitment attributed to another sender fails to open its commitment in the third round
+	tampered = *transcript
+	tampered.Inbound = append([]tss.TranscriptMessage(nil), transcript.Inbound...)
+	var decommitments []int
+	for i, msg := range tampered.Inbound {
+		if strings.HasSuffix(msg.Type, "KGRound2Message2") {
+			decommitments = append(decommitments, i)
+		}
+	}
+	if !assert.Len(t, decommitments, len(pIDs)-1) {
+		return
+	}
+	a, b := decommitments[0], decommitments[1]
+	tampered.Inbound[a].WireBytes, tampered.Inbound[b].WireBytes = tampered.Inbound[b].WireBytes, tampered.Inbound[a].WireBytes
+	report, save = replayKeygen(t, pIDs, &tampered)
+	assert.Nil(t, save)
+	if assert.NotNil(t, report.Err) && assert.NotNil(t, report.Divergence) {
+		assert.Equal(t, 3, report.Divergence.Round)
+		assert.Equal(t, report.Err.Round(), report.Divergence.Round)
+	}
+}
+
+func TestTranscriptVersion(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(2)
+	transcript := tss.NewRecorder(pIDs[0]).Transcript()
+	bz, err := transcript.Marshal()
+	assert.NoError(t, err)
+	_, err = tss.UnmarshalTranscript(bz)
+	assert.NoError(t, err)
+
+	transcript.Version = tss.TranscriptVersion + 1
+	bz, err = json.Marshal(transcript)
+	assert.NoError(t, err)
+	_, err = tss.UnmarshalTranscript(bz)
+	assert.Error(t, err, "a transcript of a newer version must be rejected")
+
+	params := tss.NewParameters(tss.Edwards(), tss.NewPeerContext(pIDs), pIDs[1], len(pIDs), 1)
+	transcript.Version = tss.TranscriptVersion
+	_, err = tss.NewReplay(transcript, keygen.NewLocalParty(params, nil, nil), nil)
+	assert.Error(t, err, "the transcript was recorded by another party")
+}
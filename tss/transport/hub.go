@@ -0,0 +1,298 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package transport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+type committee int
+
+const (
+	// the parties of a keygen or signing session, and the new committee during re-sharing
+	currentCommittee committee = iota
+	// the old committee during re-sharing
+	oldCommittee
+)
+
+type (
+	// Hub is an in-process Transport implementation that connects a set of parties running in the same process.
+	// Messages are serialised with WireBytes() on send, so each party only ever sees what it would see on a real network.
+	// Every connected party has an unbounded inbox, so Send never blocks on a slow receiver.
+	Hub struct {
+		mtx        sync.RWMutex
+		committees [2]map[string]*endpoint
+		closed     bool
+	}
+
+	// endpoint is the Transport handed out to a party connected to a Hub
+	endpoint struct {
+		hub       *Hub
+		pID       *tss.PartyID
+		committee committee
+
+		mtx    sync.Mutex
+		inbox  []delivery
+		signal chan struct{}
+		done   chan struct{}
+		closed bool
+	}
+
+	delivery struct {
+		wireBytes []byte
+		routing   tss.MessageRouting
+	}
+)
+
+var _ Transport = (*endpoint)(nil)
+
+func NewHub() *Hub {
+	return &Hub{
+		committees: [2]map[string]*endpoint{
+			currentCommittee: make(map[string]*endpoint),
+			oldCommittee:     make(map[string]*endpoint),
+		},
+	}
+}
+
+// Connect registers a keygen or signing party, or a member of the new committee during re-sharing, with the hub
+func (h *Hub) Connect(pID *tss.PartyID) (Transport, error) {
+	return h.connect(pID, currentCommittee)
+}
+
+// ConnectOldCommittee registers a member of the old committee during re-sharing with the hub
+func (h *Hub) ConnectOldCommittee(pID *tss.PartyID) (Transport, error) {
+	return h.connect(pID, oldCommittee)
+}
+
+func (h *Hub) connect(pID *tss.PartyID, c committee) (*endpoint, error) {
+	if !pID.ValidateBasic() {
+		return nil, fmt.Errorf("transport: cannot connect a party with an invalid PartyID: %v", pID)
+	}
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	if h.closed {
+		return nil, ErrClosed
+	}
+	key := string(pID.Key)
+	if _, ok := h.committees[c][key]; ok {
+		return nil, fmt.Errorf("transport: party %s is already connected", pID)
+	}
+	ep := &endpoint{
+		hub:       h,
+		pID:       pID,
+		committee: c,
+		signal:    make(chan struct{}, 1),
+		done:      make(chan struct{}),
+	}
+	h.committees[c][key] = ep
+	return ep, nil
+}
+
+// Send routes a message on behalf of its sender, msg.GetFrom().
+// This is convenient when several parties share a single `out` channel, as they do in the tests.
+func (h *Hub) Send(msg tss.Message) error {
+	return h.route(msg, nil)
+}
+
+// Close disconnects every party; blocked and future calls to Receive return ErrClosed
+func (h *Hub) Close() error {
+	h.mtx.Lock()
+	h.closed = true
+	eps := make([]*endpoint, 0, len(h.committees[currentCommittee])+len(h.committees[oldCommittee]))
+	for _, members := range h.committees {
+		for key, ep := range members {
+			eps = append(eps, ep)
+			delete(members, key)
+		}
+	}
+	h.mtx.Unlock()
+	for _, ep := range eps {
+		ep.shutdown()
+	}
+	return nil
+}
+
+func (h *Hub) route(msg tss.Message, sender *endpoint) error {
+	if msg == nil || msg.GetFrom() == nil {
+		return errors.New("transport: cannot route a message without a sender")
+	}
+	bz, routing, err := msg.WireBytes()
+	if err != nil {
+		return err
+	}
+	h.mtx.RLock()
+	if h.closed {
+		h.mtx.RUnlock()
+		return ErrClosed
+	}
+	var recipients []*endpoint
+	if routing.To == nil {
+		recipients, err = h.broadcast(msg, sender)
+	} else {
+		recipients, err = h.sendTo(msg, sender)
+	}
+	h.mtx.RUnlock()
+	if err != nil {
+		return err
+	}
+	for _, ep := range recipients {
+		ep.deliver(bz, *routing)
+	}
+	return nil
+}
+
+// targets returns the committees that a message must be fanned out to
+func (h *Hub) targets(msg tss.Message) []committee {
+	switch {
+	case msg.IsToOldAndNewCommittees():
+		return []committee{oldCommittee, currentCommittee}
+	case msg.IsToOldCommittee():
+		return []committee{oldCommittee}
+	default:
+		return []committee{currentCommittee}
+	}
+}
+
+// broadcast resolves every party in the target committees except the sender
+func (h *Hub) broadcast(msg tss.Message, sender *endpoint) ([]*endpoint, error) {
+	recipients := make([]*endpoint, 0, len(h.committees[currentCommittee]))
+	for _, c := range h.targets(msg) {
+		for _, ep := range h.committees[c] {
+			if ep.isSender(msg, sender) {
+				continue
+			}
+			recipients = append(recipients, ep)
+		}
+	}
+	return recipients, nil
+}
+
+// sendTo resolves the parties listed in GetTo() within the target committees, skipping the sender itself
+func (h *Hub) sendTo(msg tss.Message, sender *endpoint) ([]*endpoint, error) {
+	to := msg.GetTo()
+	seen := make(map[*endpoint]struct{}, len(to))
+	recipients := make([]*endpoint, 0, len(to))
+	for _, pID := range to {
+		if pID == nil {
+			return nil, fmt.Errorf("transport: message %s has a nil recipient", msg.Type())
+		}
+		found := false
+		for _, c := range h.targets(msg) {
+			ep, ok := h.committees[c][string(pID.Key)]
+			if !ok {
+				continue
+			}
+			found = true
+			if ep.isSender(msg, sender) {
+				if !msg.IsBroadcast() {
+					return nil, fmt.Errorf("transport: party %s tried to send a message to itself", msg.GetFrom())
+				}
+				continue
+			}
+			if _, dup := seen[ep]; dup {
+				continue
+			}
+			seen[ep] = struct{}{}
+			recipients = append(recipients, ep)
+		}
+		if !found {
+			return nil, fmt.Errorf("transport: recipient %s of message %s is not connected", pID, msg.Type())
+		}
+	}
+	return recipients, nil
+}
+
+// ----- //
+
+func (ep *endpoint) Send(msg tss.Message) error {
+	if ep.isClosed() {
+		return ErrClosed
+	}
+	return ep.hub.route(msg, ep)
+}
+
+func (ep *endpoint) Receive(ctx context.Context) ([]byte, *tss.MessageRouting, error) {
+	for {
+		ep.mtx.Lock()
+		if ep.closed {
+			ep.mtx.Unlock()
+			return nil, nil, ErrClosed
+		}
+		if 0 < len(ep.inbox) {
+			next := ep.inbox[0]
+			ep.inbox[0] = delivery{}
+			ep.inbox = ep.inbox[1:]
+			ep.mtx.Unlock()
+			return next.wireBytes, &next.routing, nil
+		}
+		ep.mtx.Unlock()
+		select {
+		case <-ep.signal:
+		case <-ep.done:
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		}
+	}
+}
+
+func (ep *endpoint) Close() error {
+	ep.hub.mtx.Lock()
+	key := string(ep.pID.Key)
+	if ep.hub.committees[ep.committee][key] == ep {
+		delete(ep.hub.committees[ep.committee], key)
+	}
+	ep.hub.mtx.Unlock()
+	ep.shutdown()
+	return nil
+}
+
+func (ep *endpoint) deliver(wireBytes []byte, routing tss.MessageRouting) {
+	bz := make([]byte, len(wireBytes))
+	copy(bz, wireBytes)
+	ep.mtx.Lock()
+	if ep.closed {
+		ep.mtx.Unlock()
+		return
+	}
+	ep.inbox = append(ep.inbox, delivery{wireBytes: bz, routing: routing})
+	ep.mtx.Unlock()
+	select {
+	case ep.signal <- struct{}{}:
+	default:
+	}
+}
+
+func (ep *endpoint) shutdown() {
+	ep.mtx.Lock()
+	defer ep.mtx.Unlock()
+	if ep.closed {
+		return
+	}
+	ep.closed = true
+	ep.inbox = nil
+	close(ep.done)
+}
+
+func (ep *endpoint) isClosed() bool {
+	ep.mtx.Lock()
+	defer ep.mtx.Unlock()
+	return ep.closed
+}
+
+// isSender reports whether ep belongs to the party that produced msg
+func (ep *endpoint) isSender(msg tss.Message, sender *endpoint) bool {
+	if sender != nil {
+		return ep == sender
+	}
+	return ep.pID == msg.GetFrom()
+}
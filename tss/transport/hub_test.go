@@ -0,0 +1,139 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package transport_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SafeMPC/tss-lib/crypto/vss"
+	"github.com/SafeMPC/tss-lib/eddsa/keygen"
+	"github.com/SafeMPC/tss-lib/eddsa/resharing"
+	"github.com/SafeMPC/tss-lib/tss"
+	. "github.com/SafeMPC/tss-lib/tss/transport"
+)
+
+func connectAll(t *testing.T, hub *Hub, pIDs tss.SortedPartyIDs, old bool) []Transport {
+	conns := make([]Transport, len(pIDs))
+	for i, pID := range pIDs {
+		var err error
+		if old {
+			conns[i], err = hub.ConnectOldCommittee(pID)
+		} else {
+			conns[i], err = hub.Connect(pID)
+		}
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+	}
+	return conns
+}
+
+// pending drains everything currently queued for a transport
+func pending(t *testing.T, conn Transport) []*tss.MessageRouting {
+	out := make([]*tss.MessageRouting, 0)
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		_, routing, err := conn.Receive(ctx)
+		cancel()
+		if err != nil {
+			assert.ErrorIs(t, err, context.DeadlineExceeded)
+			return out
+		}
+		out = append(out, routing)
+	}
+}
+
+func TestHubBroadcastAndPointToPoint(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(3)
+	hub := NewHub()
+	defer hub.Close()
+	conns := connectAll(t, hub, pIDs, false)
+
+	// broadcast reaches everyone but the sender
+	assert.NoError(t, conns[0].Send(keygen.NewKGRound1Message(pIDs[0], big.NewInt(1))))
+	assert.Len(t, pending(t, conns[0]), 0)
+	for _, conn := range conns[1:] {
+		got := pending(t, conn)
+		if assert.Len(t, got, 1) {
+			assert.Equal(t, pIDs[0], got[0].From)
+			assert.True(t, got[0].IsBroadcast)
+		}
+	}
+
+	// point-to-point reaches only the recipient
+	share := &vss.Share{Threshold: 1, ID: big.NewInt(1), Share: big.NewInt(42)}
+	assert.NoError(t, conns[1].Send(keygen.NewKGRound2Message1(pIDs[2], pIDs[1], share)))
+	assert.Len(t, pending(t, conns[0]), 0)
+	assert.Len(t, pending(t, conns[1]), 0)
+	got := pending(t, conns[2])
+	if assert.Len(t, got, 1) {
+		assert.False(t, got[0].IsBroadcast)
+	}
+
+	// a party may not send a point-to-point message to itself
+	assert.Error(t, conns[1].Send(keygen.NewKGRound2Message1(pIDs[1], pIDs[1], share)))
+}
+
+func TestHubReSharingFanOut(t *testing.T) {
+	oldPIDs := tss.GenerateTestPartyIDs(2)
+	newPIDs := tss.GenerateTestPartyIDs(3)
+	hub := NewHub()
+	defer hub.Close()
+	oldConns := connectAll(t, hub, oldPIDs, true)
+	newConns := connectAll(t, hub, newPIDs, false)
+
+	// to the old committee only
+	assert.NoError(t, newConns[0].Send(resharing.NewDGRound2Message(oldPIDs, newPIDs[0])))
+	for _, conn := range oldConns {
+		assert.Len(t, pending(t, conn), 1)
+	}
+	for _, conn := range newConns {
+		assert.Len(t, pending(t, conn), 0)
+	}
+
+	// to both committees, never back to the sender
+	oldAndNew := append(append(tss.SortedPartyIDs{}, oldPIDs...), newPIDs...)
+	assert.NoError(t, newConns[1].Send(resharing.NewDGRound4Message(oldAndNew, newPIDs[1])))
+	for _, conn := range oldConns {
+		assert.Len(t, pending(t, conn), 1)
+	}
+	for i, conn := range newConns {
+		if i == 1 {
+			assert.Len(t, pending(t, conn), 0)
+			continue
+		}
+		assert.Len(t, pending(t, conn), 1)
+	}
+
+	// hub-level routing on behalf of the sender
+	assert.NoError(t, hub.Send(resharing.NewDGRound2Message(oldPIDs, newPIDs[2])))
+	for _, conn := range oldConns {
+		assert.Len(t, pending(t, conn), 1)
+	}
+}
+
+func TestHubClose(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(2)
+	hub := NewHub()
+	conns := connectAll(t, hub, pIDs, false)
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, _, err := conns[0].Receive(context.Background())
+		errCh <- err
+	}()
+	assert.NoError(t, hub.Close())
+	assert.ErrorIs(t, <-errCh, ErrClosed)
+	assert.ErrorIs(t, conns[1].Send(keygen.NewKGRound1Message(pIDs[1], big.NewInt(1))), ErrClosed)
+	_, err := hub.Connect(pIDs[0])
+	assert.ErrorIs(t, err, ErrClosed)
+}
@@ -0,0 +1,39 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Package transport defines how the wire messages produced by a tss.Party reach its peers.
+//
+// A Transport is bound to a single party. Outbound messages taken from the party's `out` channel are passed to Send,
+// which routes them using the message's MessageRouting: broadcast to the whole committee when GetTo() is nil,
+// point-to-point otherwise, and to the old and/or new committee during re-sharing. Inbound messages are returned by
+// Receive in a form that can be fed straight into Party.UpdateFromBytes.
+package transport
+
+import (
+	"context"
+	"errors"
+
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+// ErrClosed is returned by Send and Receive once a Transport (or the Hub it is connected to) has been closed.
+var ErrClosed = errors.New("transport: closed")
+
+// a Transport can be used to drive a party with tss.Run
+var _ tss.Transport = Transport(nil)
+
+type (
+	// Transport delivers the messages of one party to and from its peers.
+	Transport interface {
+		// Send routes an outbound message produced by this party to its recipients
+		Send(msg tss.Message) error
+		// Receive blocks until the next inbound message for this party is available or ctx is done.
+		// The returned bytes and routing may be passed to Party.UpdateFromBytes as-is.
+		Receive(ctx context.Context) ([]byte, *tss.MessageRouting, error)
+		// Close releases the transport; any blocked or future Receive returns ErrClosed
+		Close() error
+	}
+)
@@ -0,0 +1,68 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss
+
+import (
+	"fmt"
+	"math"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// Versions of the protocol messages. Every message carries the version of the party that sent it, so that a party
+// can tell the layout of the messages that it receives and reject peers that it cannot interoperate with.
+const (
+	// ProtocolV1 is spoken by releases that predate protocol versions; their messages carry no version
+	ProtocolV1 uint32 = 1
+	// ProtocolV2 adds the protocol version to the wire bytes of every message
+	ProtocolV2 uint32 = 2
+
+	// ProtocolVersion is the version spoken by this release
+	ProtocolVersion = ProtocolV2
+)
+
+// The wire bytes of a message are the protobuf encoding of its Any with the protocol version appended as this
+// field. Parties that predate protocol versions skip the unknown field, so they can still parse the messages.
+const protocolVersionField protowire.Number = 15
+
+func appendProtocolVersion(bz []byte, version uint32) []byte {
+	if version <= ProtocolV1 {
+		return bz
+	}
+	bz = protowire.AppendTag(bz, protocolVersionField, protowire.VarintType)
+	return protowire.AppendVarint(bz, uint64(version))
+}
+
+// takeProtocolVersion removes the protocol version from the unknown fields of a parsed message and returns it
+func takeProtocolVersion(msg *anypb.Any) (uint32, error) {
+	version := ProtocolV1
+	unknown := msg.ProtoReflect().GetUnknown()
+	var rest []byte
+	for len(unknown) > 0 {
+		num, typ, n := protowire.ConsumeTag(unknown)
+		if n < 0 {
+			return 0, protowire.ParseError(n)
+		}
+		m := protowire.ConsumeFieldValue(num, typ, unknown[n:])
+		if m < 0 {
+			return 0, protowire.ParseError(m)
+		}
+		if num == protocolVersionField && typ == protowire.VarintType {
+			v, _ := protowire.ConsumeVarint(unknown[n:])
+			if v < uint64(ProtocolV1) || math.MaxUint32 < v {
+				return 0, fmt.Errorf("the message has an invalid protocol version %d", v)
+			}
+			version = uint32(v)
+		} else {
+			rest = append(rest, unknown[:n+m]...)
+		}
+		unknown = unknown[n+m:]
+	}
+	msg.ProtoReflect().SetUnknown(rest)
+	return version, nil
+}
@@ -0,0 +1,127 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/SafeMPC/tss-lib/common"
+	"github.com/SafeMPC/tss-lib/eddsa/keygen"
+	"github.com/SafeMPC/tss-lib/eddsa/signing"
+	"github.com/SafeMPC/tss-lib/test"
+	"github.com/SafeMPC/tss-lib/tss"
+	"github.com/SafeMPC/tss-lib/tss/transport"
+)
+
+func TestProtocolVersion(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(2)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	newParams := func(i int, version uint32, compatible bool) *tss.Parameters {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[i], len(pIDs), 1)
+		params.SetProtocolVersion(version)
+		if compatible {
+			params.SetCompatibilityMode()
+		}
+		return params
+	}
+	msg := keygen.NewKGRound1Message(pIDs[0], big.NewInt(42))
+	assert.Equal(t, tss.ProtocolVersion, newParams(0, tss.ProtocolVersion, false).ProtocolVersion())
+
+	// a message sealed by a party that predates protocol versions is a bare Any, which parties of all versions can parse
+	sealed, err := newParams(0, tss.ProtocolV1, false).SealWireMessage(msg)
+	if !assert.NoError(t, err) {
+		return
+	}
+	legacy := wireBytes(t, sealed)
+	bare, err := proto.Marshal(msg.WireMsg().GetMessage())
+	assert.NoError(t, err)
+	assert.Equal(t, bare, legacy)
+	assert.NoError(t, proto.Unmarshal(wireBytes(t, msg), new(anypb.Any)))
+
+	parsed, err := newParams(1, tss.ProtocolVersion, false).ParseWireMessage(wireBytes(t, msg), pIDs[0], true)
+	if assert.NoError(t, err) {
+		assert.Equal(t, tss.ProtocolVersion, parsed.ProtocolVersion())
+		assert.Equal(t, wireBytes(t, msg), wireBytes(t, parsed), "a parsed message must have the same wire bytes")
+	}
+	_, err = newParams(1, tss.ProtocolVersion, false).ParseWireMessage(legacy, pIDs[0], true)
+	assert.True(t, errors.Is(err, tss.ErrVersionMismatch), "an older peer must be rejected outside of compatibility mode")
+	parsed, err = newParams(1, tss.ProtocolVersion, true).ParseWireMessage(legacy, pIDs[0], true)
+	if assert.NoError(t, err) {
+		assert.Equal(t, tss.ProtocolV1, parsed.ProtocolVersion())
+	}
+	_, err = newParams(1, tss.ProtocolV1, false).ParseWireMessage(wireBytes(t, msg), pIDs[0], true)
+	assert.NoError(t, err, "parties that predate protocol versions ignore them")
+
+	sealed, err = newParams(0, tss.ProtocolVersion+1, false).SealWireMessage(msg)
+	if assert.NoError(t, err) {
+		_, err = newParams(1, tss.ProtocolVersion, true).ParseWireMessage(wireBytes(t, sealed), pIDs[0], true)
+		assert.True(t, errors.Is(err, tss.ErrVersionMismatch), "a newer peer must be rejected")
+	}
+}
+
+func TestRunMixedProtocolVersions(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(test.TestParticipants)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	// party 0 has not been upgraded yet and the others run in compatibility mode
+	configure := func(i int, params *tss.Parameters) {
+		if i == 0 {
+			params.SetProtocolVersion(tss.ProtocolV1)
+		} else {
+			params.SetCompatibilityMode()
+		}
+	}
+
+	keys := make([]keygen.LocalPartySaveData, len(pIDs))
+	for _, res := range runKeygen(ctx, t, pIDs, configure) {
+		if !assert.Nil(t, res.err) {
+			return
+		}
+		keys[pIDs.FindByKey(res.save.ShareID).Index] = *res.save
+	}
+
+	hub := transport.NewHub()
+	defer hub.Close()
+	p2pCtx := tss.NewPeerContext(pIDs)
+	results := make(chan *tss.Error, len(pIDs))
+	sigs := make(chan *common.SignatureData, len(pIDs))
+	for i, pID := range pIDs {
+		conn, err := hub.Connect(pID)
+		if !assert.NoError(t, err) {
+			return
+		}
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pID, len(pIDs), test.TestThreshold)
+		configure(i, params)
+		outCh := make(chan tss.Message, len(pIDs))
+		endCh := make(chan *common.SignatureData, 1)
+		P := signing.NewLocalParty(big.NewInt(42), params, keys[i], outCh, endCh)
+		go func() {
+			sig, err := tss.Run(ctx, P, conn, outCh, endCh)
+			sigs <- sig
+			results <- err
+		}()
+	}
+	pk := edwards.PublicKey{Curve: tss.Edwards(), X: keys[0].EDDSAPub.X(), Y: keys[0].EDDSAPub.Y()}
+	for range pIDs {
+		if !assert.Nil(t, <-results) {
+			return
+		}
+		sig, err := edwards.ParseSignature((<-sigs).GetSignature())
+		if assert.NoError(t, err) {
+			assert.True(t, edwards.Verify(&pk, big.NewInt(42).Bytes(), sig.R, sig.S), "eddsa verify must pass")
+		}
+	}
+}
@@ -8,12 +8,23 @@ package tss
 
 import (
 	"errors"
+	"fmt"
+
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
-// Used externally to update a LocalParty with a valid ParsedMessage
+// Used externally to update a LocalParty with a valid ParsedMessage.
+// Wire bytes over DefaultMaxWireSize are rejected; use Parameters.ParseWireMessage to parse under another limit.
 func ParseWireMessage(wireBytes []byte, from *PartyID, isBroadcast bool) (ParsedMessage, error) {
+	if DefaultMaxWireSize < len(wireBytes) {
+		return nil, Classify(fmt.Errorf("the message of %d bytes exceeds the limit of %d bytes", len(wireBytes), DefaultMaxWireSize), ErrBadMessage)
+	}
+	return parseWireMessage(wireBytes, from, isBroadcast)
+}
+
+// parseWireMessage parses the wire bytes of a message without limiting their size
+func parseWireMessage(wireBytes []byte, from *PartyID, isBroadcast bool) (ParsedMessage, error) {
 	wire := new(MessageWrapper)
 	wire.Message = new(anypb.Any)
 	wire.From = from.MessageWrapper_PartyID
@@ -21,7 +32,17 @@ func ParseWireMessage(wireBytes []byte, from *PartyID, isBroadcast bool) (Parsed
 	if err := proto.Unmarshal(wireBytes, wire.Message); err != nil {
 		return nil, err
 	}
-	return parseWrappedMessage(wire, from)
+	version, err := takeProtocolVersion(wire.Message)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := parseWrappedMessage(wire, from)
+	if err != nil {
+		return nil, err
+	}
+	impl := msg.(*MessageImpl)
+	impl.version, impl.received = version, wireBytes
+	return msg, nil
 }
 
 func parseWrappedMessage(wire *MessageWrapper, from *PartyID) (ParsedMessage, error) {
@@ -0,0 +1,184 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/SafeMPC/tss-lib/common"
+)
+
+// Priority orders the work of the parties that share a WorkerPool
+type Priority int
+
+const (
+	// PriorityLow is for background work, such as the generation of pre-parameters
+	PriorityLow Priority = iota - 1
+	// PriorityNormal is the default priority of a party
+	PriorityNormal
+	// PriorityHigh is for latency-sensitive work, such as signing
+	PriorityHigh
+)
+
+// WorkerPool runs the heavy cryptographic work of many parties, such as the generation and verification of proofs,
+// Paillier operations and the search for safe primes, on a fixed number of workers so that running many sessions at
+// once does not oversubscribe the CPU. Share one pool between parties with Parameters.SetWorkerPool.
+//
+// Queued work is run in order of priority. Within a priority the parties take turns, so that a party with a lot of
+// work does not hold up the others. Low priority work never occupies every worker, so that a pool with more than one
+// worker always has one for more urgent work while it generates pre-parameters. Work is not preempted once it runs.
+type WorkerPool struct {
+	mtx     sync.Mutex
+	cond    *sync.Cond
+	levels  [PriorityHigh - PriorityLow + 1]poolLevel
+	workers int
+	lowRuns int // the number of workers running low priority work
+	closed  bool
+	wg      sync.WaitGroup
+}
+
+// poolLevel queues the work of one priority; the sessions with queued work take turns in `order`
+type poolLevel struct {
+	queues map[any][]func()
+	order  []any
+}
+
+type poolExecutor struct {
+	pool     *WorkerPool
+	session  any
+	priority Priority
+}
+
+// NewWorkerPool starts a pool with the given number of workers, or runtime.GOMAXPROCS(0) if it is not positive.
+// Close the pool to stop its workers.
+func NewWorkerPool(workers int) *WorkerPool {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	pool := &WorkerPool{workers: workers}
+	pool.cond = sync.NewCond(&pool.mtx)
+	pool.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go pool.work()
+	}
+	return pool
+}
+
+// Workers is the number of workers in the pool
+func (pool *WorkerPool) Workers() int {
+	return pool.workers
+}
+
+// Queued is the number of functions that are waiting for a worker
+func (pool *WorkerPool) Queued() int {
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+	queued := 0
+	for _, level := range pool.levels {
+		for _, queue := range level.queues {
+			queued += len(queue)
+		}
+	}
+	return queued
+}
+
+// Executor returns an Executor that queues functions on the pool for a session at a priority.
+// Sessions are compared with ==; a party uses its Parameters.
+func (pool *WorkerPool) Executor(session any, priority Priority) common.Executor {
+	if priority < PriorityLow {
+		priority = PriorityLow
+	} else if PriorityHigh < priority {
+		priority = PriorityHigh
+	}
+	return poolExecutor{pool: pool, session: session, priority: priority}
+}
+
+// Close stops the workers once they have run the functions that are queued, and waits for them.
+// Functions that are given to the pool afterwards run on goroutines of their own.
+func (pool *WorkerPool) Close() {
+	pool.mtx.Lock()
+	pool.closed = true
+	pool.cond.Broadcast()
+	pool.mtx.Unlock()
+	pool.wg.Wait()
+}
+
+func (exec poolExecutor) Go(f func()) {
+	exec.pool.submit(exec.session, exec.priority, f)
+}
+
+func (pool *WorkerPool) submit(session any, priority Priority, f func()) {
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+	if pool.closed {
+		go f()
+		return
+	}
+	level := &pool.levels[priority-PriorityLow]
+	if level.queues == nil {
+		level.queues = make(map[any][]func())
+	}
+	if len(level.queues[session]) == 0 {
+		level.order = append(level.order, session)
+	}
+	level.queues[session] = append(level.queues[session], f)
+	pool.cond.Signal()
+}
+
+// next takes the next function to run, if there is one that may run. The caller must hold the lock.
+func (pool *WorkerPool) next() (func(), Priority, bool) {
+	for priority := PriorityHigh; PriorityLow <= priority; priority-- {
+		level := &pool.levels[priority-PriorityLow]
+		if len(level.order) == 0 {
+			continue
+		}
+		if priority == PriorityLow && 1 < pool.workers && pool.workers-1 <= pool.lowRuns {
+			continue
+		}
+		session := level.order[0]
+		queue := level.queues[session]
+		f := queue[0]
+		queue[0] = nil
+		level.order = level.order[1:]
+		if queue = queue[1:]; len(queue) == 0 {
+			delete(level.queues, session)
+		} else {
+			level.queues[session] = queue
+			level.order = append(level.order, session)
+		}
+		return f, priority, true
+	}
+	return nil, 0, false
+}
+
+func (pool *WorkerPool) work() {
+	defer pool.wg.Done()
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+	for {
+		f, priority, ok := pool.next()
+		if !ok {
+			if pool.closed {
+				return
+			}
+			pool.cond.Wait()
+			continue
+		}
+		if priority == PriorityLow {
+			pool.lowRuns++
+		}
+		pool.mtx.Unlock()
+		f()
+		pool.mtx.Lock()
+		if priority == PriorityLow {
+			pool.lowRuns--
+			// low priority work that was held back may run now
+			pool.cond.Broadcast()
+		}
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright © 2026 SafeMPC
+//
+// This file is part of SafeMPC. The full SafeMPC copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SafeMPC/tss-lib/common"
+	"github.com/SafeMPC/tss-lib/tss"
+)
+
+// blockWorkers occupies every worker of the pool until the returned function is called
+func blockWorkers(pool *tss.WorkerPool) func() {
+	release := make(chan struct{})
+	var started sync.WaitGroup
+	started.Add(pool.Workers())
+	for i := 0; i < pool.Workers(); i++ {
+		pool.Executor("blocker", tss.PriorityHigh).Go(func() {
+			started.Done()
+			<-release
+		})
+	}
+	started.Wait()
+	return func() { close(release) }
+}
+
+func TestWorkerPoolOrder(t *testing.T) {
+	pool := tss.NewWorkerPool(1)
+	defer pool.Close()
+	release := blockWorkers(pool)
+
+	var mtx sync.Mutex
+	var wg sync.WaitGroup
+	var order []string
+	queue := func(exec common.Executor, name string) {
+		wg.Add(1)
+		exec.Go(func() {
+			defer wg.Done()
+			mtx.Lock()
+			defer mtx.Unlock()
+			order = append(order, name)
+		})
+	}
+	for _, name := range []string{"a1", "a2", "a3"} {
+		queue(pool.Executor("a", tss.PriorityNormal), name)
+	}
+	queue(pool.Executor("b", tss.PriorityNormal), "b1")
+	queue(pool.Executor("low", tss.PriorityLow), "low")
+	queue(pool.Executor("c", tss.PriorityNormal), "c1")
+	queue(pool.Executor("high", tss.PriorityHigh), "high")
+	assert.Equal(t, 7, pool.Queued())
+	release()
+	wg.Wait()
+	// the sessions take turns within a priority
+	assert.Equal(t, []string{"high", "a1", "b1", "c1", "a2", "a3", "low"}, order)
+	assert.Equal(t, 0, pool.Queued())
+}
+
+func TestWorkerPoolLowPriority(t *testing.T) {
+	pool := tss.NewWorkerPool(2)
+	defer pool.Close()
+
+	// low priority work leaves a worker free
+	release := make(chan struct{})
+	defer close(release)
+	low := pool.Executor("background", tss.PriorityLow)
+	for i := 0; i < 2; i++ {
+		low.Go(func() { <-release })
+	}
+	done := make(chan struct{})
+	pool.Executor("signing", tss.PriorityHigh).Go(func() { close(done) })
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		assert.FailNow(t, "the urgent work did not run")
+	}
+	assert.Equal(t, 1, pool.Queued(), "the second low priority function must wait for the first")
+
+	// parties use the pool that is set on their parameters
+	params := tss.NewParameters(tss.Edwards(), nil, nil, 2, 1)
+	assert.Equal(t, common.GoExecutor, params.Executor())
+	params.SetWorkerPool(pool)
+	params.SetPriority(tss.PriorityHigh)
+	assert.Equal(t, pool, params.WorkerPool())
+	assert.Equal(t, tss.PriorityHigh, params.Priority())
+	common.RunOn(params.Executor(), func() {})
+}
+
+func TestWorkerPoolNestedWork(t *testing.T) {
+	pool := tss.NewWorkerPool(1)
+	defer pool.Close()
+	exec := pool.Executor("proofs", tss.PriorityNormal)
+
+	// work that runs on the only worker can still spread its parts over the pool, as the proofs do
+	ran := make([]int, 16)
+	done := make(chan struct{})
+	exec.Go(func() {
+		defer close(done)
+		common.ForEachOn(exec, len(ran), func(i int) {
+			ran[i]++
+		})
+	})
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		assert.FailNow(t, "the nested work did not run")
+	}
+	for i, n := range ran {
+		assert.Equal(t, 1, n, "part %d", i)
+	}
+}